@@ -0,0 +1,178 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/bufbuild/connect-crosstest/internal/clienttransport"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-go"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// benchmarkClient calls the test server with either connect-go or grpc-go, so
+// that both are benchmarked by the same code.
+type benchmarkClient interface {
+	unaryCall(ctx context.Context, request *testpb.SimpleRequest) error
+	fullDuplexCall(ctx context.Context) fullDuplexStream
+}
+
+// fullDuplexStream is a FullDuplexCall stream. The response side must be
+// closed once receive returns an error.
+type fullDuplexStream interface {
+	send(request *testpb.StreamingOutputCallRequest) error
+	closeSend() error
+	receive() (*testpb.StreamingOutputCallResponse, error)
+	close() error
+}
+
+// newBenchmarkClient returns a client calling the test server with the
+// implementation given by the flags.
+func newBenchmarkClient(flags *flags) benchmarkClient {
+	address := net.JoinHostPort(flags.host, flags.port)
+	var tlsConfig *tls.Config
+	if !flags.insecure {
+		var err error
+		tlsConfig, err = clienttransport.NewTLSConfig(flags.caCertFile, "", "", tls.VersionTLS12)
+		if err != nil {
+			log.Fatalf("failed to create TLS config: %v", err)
+		}
+	}
+	switch flags.implementation {
+	case connectH2, connectGRPCH2:
+		var clientOptions []connect.ClientOption
+		if flags.implementation == connectGRPCH2 {
+			clientOptions = append(clientOptions, connect.WithGRPC())
+		}
+		transport := &http2.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+		scheme := "https://"
+		if flags.insecure {
+			transport = clienttransport.NewH2C()
+			scheme = "http://"
+		}
+		return &connectClient{
+			client: testingconnect.NewTestServiceClient(
+				&http.Client{Transport: transport},
+				scheme+address,
+				clientOptions...,
+			),
+		}
+	case grpcGo:
+		transportCredentials := insecure.NewCredentials()
+		if !flags.insecure {
+			transportCredentials = credentials.NewTLS(tlsConfig)
+		}
+		clientConn, err := grpc.Dial(address, grpc.WithTransportCredentials(transportCredentials))
+		if err != nil {
+			log.Fatalf("failed grpc dial: %v", err)
+		}
+		return &grpcClient{
+			client: testpb.NewTestServiceClient(clientConn),
+		}
+	default:
+		log.Fatalf(`the --implementation or -i flag is invalid"`)
+		return nil
+	}
+}
+
+type connectClient struct {
+	client testingconnect.TestServiceClient
+}
+
+func (c *connectClient) unaryCall(ctx context.Context, request *testpb.SimpleRequest) error {
+	_, err := c.client.UnaryCall(ctx, connect.NewRequest(request))
+	return err
+}
+
+func (c *connectClient) fullDuplexCall(ctx context.Context) fullDuplexStream {
+	return &connectStream{stream: c.client.FullDuplexCall(ctx)}
+}
+
+type connectStream struct {
+	stream *connect.BidiStreamForClient[testpb.StreamingOutputCallRequest, testpb.StreamingOutputCallResponse]
+}
+
+func (s *connectStream) send(request *testpb.StreamingOutputCallRequest) error {
+	return s.stream.Send(request)
+}
+
+func (s *connectStream) closeSend() error {
+	return s.stream.CloseRequest()
+}
+
+func (s *connectStream) receive() (*testpb.StreamingOutputCallResponse, error) {
+	return s.stream.Receive()
+}
+
+func (s *connectStream) close() error {
+	return s.stream.CloseResponse()
+}
+
+type grpcClient struct {
+	client testpb.TestServiceClient
+}
+
+func (c *grpcClient) unaryCall(ctx context.Context, request *testpb.SimpleRequest) error {
+	_, err := c.client.UnaryCall(ctx, request)
+	return err
+}
+
+func (c *grpcClient) fullDuplexCall(ctx context.Context) fullDuplexStream {
+	stream, err := c.client.FullDuplexCall(ctx)
+	return &grpcStream{stream: stream, err: err}
+}
+
+// grpcStream defers the error of opening the stream to the first call, to
+// match connect-go.
+type grpcStream struct {
+	stream testpb.TestService_FullDuplexCallClient
+	err    error
+}
+
+func (s *grpcStream) send(request *testpb.StreamingOutputCallRequest) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.stream.Send(request)
+}
+
+func (s *grpcStream) closeSend() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.stream.CloseSend()
+}
+
+func (s *grpcStream) receive() (*testpb.StreamingOutputCallResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.stream.Recv()
+}
+
+func (s *grpcStream) close() error {
+	return nil
+}
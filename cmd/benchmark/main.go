@@ -0,0 +1,135 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	hostFlagName           = "host"
+	portFlagName           = "port"
+	implementationFlagName = "implementation"
+	rpcFlagName            = "rpc"
+	insecureFlagName       = "insecure"
+	caCertFlagName         = "cacert"
+	durationFlagName       = "duration"
+	concurrencyFlagName    = "concurrency"
+	requestSizeFlagName    = "request-size"
+	responseSizeFlagName   = "response-size"
+	windowFlagName         = "window"
+)
+
+const (
+	connectH2     = "connect-h2"
+	connectGRPCH2 = "connect-grpc-h2"
+	grpcGo        = "grpc-go"
+)
+
+const (
+	unaryRPC      = "unary"
+	fullDuplexRPC = "full-duplex"
+)
+
+type flags struct {
+	host           string
+	port           string
+	implementation string
+	rpc            string
+	insecure       bool
+	caCertFile     string
+	duration       time.Duration
+	concurrency    int
+	requestSize    int
+	responseSize   int
+	window         int
+}
+
+func main() {
+	flagset := &flags{}
+	rootCmd := &cobra.Command{
+		Use:   "benchmark",
+		Short: "Benchmarks the unary or bidi streaming throughput of a test server over HTTP/2",
+		Run: func(cmd *cobra.Command, args []string) {
+			run(flagset)
+		},
+	}
+	if err := bind(rootCmd, flagset); err != nil {
+		os.Exit(1)
+	}
+	_ = rootCmd.Execute()
+}
+
+func bind(cmd *cobra.Command, flags *flags) error {
+	cmd.Flags().StringVar(&flags.host, hostFlagName, "127.0.0.1", "the host name of the test server")
+	cmd.Flags().StringVar(&flags.port, portFlagName, "", "the HTTP/2 port of the test server")
+	cmd.Flags().StringVarP(
+		&flags.implementation,
+		implementationFlagName,
+		"i",
+		connectH2,
+		fmt.Sprintf(
+			"the client implementation benchmarked, accepted values are %q, %q, or %q",
+			connectH2,
+			connectGRPCH2,
+			grpcGo,
+		),
+	)
+	cmd.Flags().StringVar(
+		&flags.rpc,
+		rpcFlagName,
+		unaryRPC,
+		fmt.Sprintf("the RPC benchmarked, accepted values are %q (UnaryCall) or %q (FullDuplexCall)", unaryRPC, fullDuplexRPC),
+	)
+	cmd.Flags().BoolVar(&flags.insecure, insecureFlagName, false, "connect without TLS, using h2c")
+	cmd.Flags().StringVar(&flags.caCertFile, caCertFlagName, "cert/CrosstestCA.crt", "path to the CA cert file used to verify the server")
+	cmd.Flags().DurationVar(&flags.duration, durationFlagName, 10*time.Second, "how long to run the benchmark for")
+	cmd.Flags().IntVar(&flags.concurrency, concurrencyFlagName, 1, "the number of concurrent calls, or streams")
+	cmd.Flags().IntVar(&flags.requestSize, requestSizeFlagName, 0, "the payload size of each request message, in bytes")
+	cmd.Flags().IntVar(&flags.responseSize, responseSizeFlagName, 0, "the payload size of each response message, in bytes")
+	cmd.Flags().IntVar(&flags.window, windowFlagName, 16, "the number of request messages each stream sends ahead of the responses it received")
+	for _, requiredFlag := range []string{portFlagName} {
+		if err := cmd.MarkFlagRequired(requiredFlag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func run(flags *flags) {
+	if flags.concurrency < 1 {
+		log.Fatalf("the --%s flag must be at least 1", concurrencyFlagName)
+	}
+	if flags.requestSize < 0 || flags.responseSize < 0 {
+		log.Fatalf("the --%s and --%s flags can't be negative", requestSizeFlagName, responseSizeFlagName)
+	}
+	if flags.window < 1 {
+		log.Fatalf("the --%s flag must be at least 1", windowFlagName)
+	}
+	client := newBenchmarkClient(flags)
+	switch flags.rpc {
+	case unaryRPC:
+		runUnary(flags, client)
+	case fullDuplexRPC:
+		runFullDuplex(flags, client)
+	default:
+		log.Fatalf("the --%s flag is invalid", rpcFlagName)
+	}
+}
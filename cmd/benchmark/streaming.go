@@ -0,0 +1,161 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+)
+
+// runFullDuplex opens the given number of FullDuplexCall streams, each sending
+// requests and receiving responses for the duration of the benchmark, and
+// reports the send and receive rates.
+func runFullDuplex(flags *flags, client benchmarkClient) {
+	request := &testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{Size: int32(flags.responseSize)}},
+		Payload:            &testpb.Payload{Body: make([]byte, flags.requestSize)},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flags.duration)
+	defer cancel()
+	results := make([]streamResult, flags.concurrency)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := range results {
+		wg.Add(1)
+		go func(result *streamResult) {
+			defer wg.Done()
+			result.run(ctx, client, request, flags.window)
+		}(&results[i])
+	}
+	wg.Wait()
+	reportFullDuplex(flags, time.Since(start), results)
+}
+
+// streamResult holds the counts of a single stream. The send side is only
+// written by the sending goroutine, and the receive side by the receiving one.
+type streamResult struct {
+	sent          int
+	sentBytes     int
+	sendDuration  time.Duration
+	sendErr       error
+	received      int
+	receivedBytes int
+	receiveErr    error
+}
+
+// run sends requests until the context is done, with at most window requests
+// whose response hasn't been received yet, then closes the send side and
+// receives the remaining responses. The stream itself isn't bound to the
+// context, so that ending the benchmark doesn't fail it.
+func (r *streamResult) run(
+	ctx context.Context,
+	client benchmarkClient,
+	request *testpb.StreamingOutputCallRequest,
+	window int,
+) {
+	start := time.Now()
+	stream := client.fullDuplexCall(context.Background())
+	inFlight := make(chan struct{}, window)
+	receiveDone := make(chan struct{})
+	go func() {
+		defer close(receiveDone)
+		for {
+			response, err := stream.receive()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				r.receiveErr = err
+				return
+			}
+			r.received++
+			r.receivedBytes += len(response.GetPayload().GetBody())
+			<-inFlight
+		}
+	}()
+sendLoop:
+	for {
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			break sendLoop
+		case <-receiveDone:
+			break sendLoop
+		}
+		if err := stream.send(request); err != nil {
+			// io.EOF means the stream failed, and receive returns the error
+			if !errors.Is(err, io.EOF) {
+				r.sendErr = err
+			}
+			break
+		}
+		r.sent++
+		r.sentBytes += len(request.GetPayload().GetBody())
+	}
+	if err := stream.closeSend(); err != nil && r.sendErr == nil {
+		r.sendErr = err
+	}
+	r.sendDuration = time.Since(start)
+	<-receiveDone
+	_ = stream.close()
+}
+
+func reportFullDuplex(flags *flags, elapsed time.Duration, results []streamResult) {
+	var sent, sentBytes, received, receivedBytes, errors int
+	var sendElapsed time.Duration
+	var firstErr error
+	for _, result := range results {
+		if result.sendDuration > sendElapsed {
+			sendElapsed = result.sendDuration
+		}
+		sent += result.sent
+		sentBytes += result.sentBytes
+		received += result.received
+		receivedBytes += result.receivedBytes
+		for _, err := range []error{result.sendErr, result.receiveErr} {
+			if err == nil {
+				continue
+			}
+			errors++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	// responses are still received once the send side is closed, so the rates
+	// are measured over different durations
+	sendSeconds := sendElapsed.Seconds()
+	receiveSeconds := elapsed.Seconds()
+	fmt.Printf("implementation: %s\n", flags.implementation)
+	fmt.Printf("streams:        %d\n", flags.concurrency)
+	fmt.Printf("window:         %d messages\n", flags.window)
+	fmt.Printf("request size:   %d bytes\n", flags.requestSize)
+	fmt.Printf("response size:  %d bytes\n", flags.responseSize)
+	fmt.Printf("duration:       %v\n", elapsed.Round(time.Millisecond))
+	fmt.Printf("sent:           %d messages, %d bytes\n", sent, sentBytes)
+	fmt.Printf("received:       %d messages, %d bytes\n", received, receivedBytes)
+	fmt.Printf("errors:         %d\n", errors)
+	if firstErr != nil {
+		fmt.Printf("first error:    %v\n", firstErr)
+	}
+	fmt.Printf("send rate:      %.1f messages/s, %.1f bytes/s\n", float64(sent)/sendSeconds, float64(sentBytes)/sendSeconds)
+	fmt.Printf("receive rate:   %.1f messages/s, %.1f bytes/s\n", float64(received)/receiveSeconds, float64(receivedBytes)/receiveSeconds)
+}
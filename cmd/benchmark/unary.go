@@ -0,0 +1,121 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+)
+
+// runUnary calls UnaryCall with the given concurrency for the duration of the
+// benchmark, and reports the QPS and latency percentiles.
+func runUnary(flags *flags, client benchmarkClient) {
+	request := &testpb.SimpleRequest{
+		ResponseSize: int32(flags.responseSize),
+		Payload:      &testpb.Payload{Body: make([]byte, flags.requestSize)},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flags.duration)
+	defer cancel()
+	results := make([]unaryResult, flags.concurrency)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := range results {
+		wg.Add(1)
+		go func(result *unaryResult) {
+			defer wg.Done()
+			result.run(ctx, client, request)
+		}(&results[i])
+	}
+	wg.Wait()
+	reportUnary(flags, time.Since(start), results)
+}
+
+// unaryResult holds the latencies of the calls made by a single worker, so
+// that the workers don't contend on recording them.
+type unaryResult struct {
+	latencies []time.Duration
+	errors    int
+	firstErr  error
+}
+
+func (r *unaryResult) run(
+	ctx context.Context,
+	client benchmarkClient,
+	request *testpb.SimpleRequest,
+) {
+	for ctx.Err() == nil {
+		callStart := time.Now()
+		err := client.unaryCall(ctx, request)
+		latency := time.Since(callStart)
+		if ctx.Err() != nil {
+			// the call was cut off by the end of the benchmark
+			return
+		}
+		if err != nil {
+			r.errors++
+			if r.firstErr == nil {
+				r.firstErr = err
+			}
+			continue
+		}
+		r.latencies = append(r.latencies, latency)
+	}
+}
+
+func reportUnary(flags *flags, elapsed time.Duration, results []unaryResult) {
+	var latencies []time.Duration
+	var errors int
+	var firstErr error
+	for _, result := range results {
+		latencies = append(latencies, result.latencies...)
+		errors += result.errors
+		if firstErr == nil {
+			firstErr = result.firstErr
+		}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	fmt.Printf("implementation: %s\n", flags.implementation)
+	fmt.Printf("concurrency:    %d\n", flags.concurrency)
+	fmt.Printf("request size:   %d bytes\n", flags.requestSize)
+	fmt.Printf("response size:  %d bytes\n", flags.responseSize)
+	fmt.Printf("duration:       %v\n", elapsed.Round(time.Millisecond))
+	fmt.Printf("calls:          %d\n", len(latencies))
+	fmt.Printf("errors:         %d\n", errors)
+	if firstErr != nil {
+		fmt.Printf("first error:    %v\n", firstErr)
+	}
+	fmt.Printf("qps:            %.1f\n", float64(len(latencies))/elapsed.Seconds())
+	for _, percentile := range []int{50, 90, 99} {
+		fmt.Printf("p%d latency:    %v\n", percentile, latencyPercentile(latencies, percentile))
+	}
+}
+
+// latencyPercentile returns the given percentile of the sorted latencies, using
+// the nearest-rank method.
+func latencyPercentile(sorted []time.Duration, percentile int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (percentile*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -15,19 +15,27 @@
 package main
 
 import (
+	compressgzip "compress/gzip"
+	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
+	"github.com/bufbuild/connect-crosstest/internal/clienttransport"
+	"github.com/bufbuild/connect-crosstest/internal/compression"
 	"github.com/bufbuild/connect-crosstest/internal/console"
+	"github.com/bufbuild/connect-crosstest/internal/crosstesting"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/health/v1/healthv1connect"
 	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	healthv1 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/health/v1"
 	testgrpc "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/bufbuild/connect-crosstest/internal/interop/interopconnect"
 	"github.com/bufbuild/connect-crosstest/internal/interop/interopgrpc"
 	"github.com/bufbuild/connect-go"
@@ -36,15 +44,44 @@ import (
 	"golang.org/x/net/http2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
 const (
-	hostFlagName           = "host"
-	portFlagName           = "port"
-	implementationFlagName = "implementation"
-	certFlagName           = "cert"
-	keyFlagName            = "key"
+	hostFlagName                 = "host"
+	portFlagName                 = "port"
+	implementationFlagName       = "implementation"
+	insecureFlagName             = "insecure"
+	caCertFlagName               = "cacert"
+	certFlagName                 = "cert"
+	keyFlagName                  = "key"
+	soakIterationsFlagName       = "soak-iterations"
+	soakMaxFailuresFlagName      = "soak-max-failures"
+	reportJSONFlagName           = "report-json"
+	verboseFlagName              = "verbose"
+	parallelismFlagName          = "parallelism"
+	acceptEncodingFlagName       = "accept-encoding"
+	authTokenFlagName            = "auth-token"
+	unixSocketFlagName           = "unix-socket"
+	keepaliveIntervalFlagName    = "keepalive-interval"
+	keepaliveTimeoutFlagName     = "keepalive-timeout"
+	maxConnectionAgeFlagName     = "max-connection-age"
+	maxConcurrentStreamsFlagName = "max-concurrent-streams"
+	streamMessageDelayFlagName   = "stream-message-delay"
+	retryMaxRetriesFlagName      = "retry-max-retries"
+	retryBackoffFlagName         = "retry-backoff"
+	gzipLevelFlagName            = "gzip-level"
+	countFlagName                = "count"
+	tlsMinVersionFlagName        = "tls-min-version"
+	requestTimeoutFlagName       = "request-timeout"
+)
+
+const (
+	soakPerIterationTimeout = 10 * time.Second
+	clientReadMaxBytes      = 1024 * 1024
 )
 
 const (
@@ -56,15 +93,44 @@ const (
 	connectGRPCWebH1 = "connect-grpc-web-h1"
 	connectGRPCWebH2 = "connect-grpc-web-h2"
 	connectGRPCWebH3 = "connect-grpc-web-h3"
-	grpcGo           = "grpc-go"
+	// connectGRPCWebEnvoy is gRPC-Web over HTTP/1.1, like browsers, through an
+	// Envoy proxy translating it to gRPC for the test server
+	connectGRPCWebEnvoy = "connect-grpc-web-envoy"
+	grpcGo              = "grpc-go"
 )
 
 type flags struct {
-	host           string
-	port           string
-	implementation string
-	certFile       string
-	keyFile        string
+	host                 string
+	port                 string
+	implementation       string
+	insecure             bool
+	caCertFile           string
+	certFile             string
+	keyFile              string
+	soakIterations       int
+	soakMaxFailures      int
+	reportJSONFile       string
+	verbose              bool
+	parallelism          int
+	acceptEncodings      []string
+	authToken            string
+	unixSocket           string
+	keepalive            keepaliveParams
+	maxConnectionAge     time.Duration
+	maxConcurrentStreams int
+	streamMessageDelay   time.Duration
+	retryMaxRetries      int
+	retryBackoff         time.Duration
+	gzipLevel            int
+	count                int
+	tlsMinVersion        string
+	requestTimeout       time.Duration
+}
+
+// keepaliveParams configures the HTTP/2 keepalive pings of the clients.
+type keepaliveParams struct {
+	interval time.Duration
+	timeout  time.Duration
 }
 
 func main() {
@@ -73,7 +139,45 @@ func main() {
 		Use:   "client",
 		Short: "Starts a grpc or connect client, based on implementation",
 		Run: func(cmd *cobra.Command, args []string) {
-			run(flagset)
+			console.SetVerbose(flagset.verbose)
+			if flagset.reportJSONFile != "" {
+				console.EnableReport(flagset.reportJSONFile)
+			}
+			if err := checkFlags(flagset); err != nil {
+				log.Fatal(err)
+			}
+			// run the suite sequentially for each iteration, which failures report
+			// when there's more than one, and keep going after failures so that all
+			// the failing iterations are reported
+			var failedIterations []int
+			for iteration := 1; iteration <= flagset.count; iteration++ {
+				if flagset.count > 1 {
+					console.SetIteration(iteration)
+				}
+				failures := console.Failures()
+				if err := run(flagset); err != nil {
+					if flagset.count > 1 {
+						log.Printf("FATAL: iteration %d: %v", iteration, err)
+					} else {
+						log.Printf("FATAL: %v", err)
+					}
+					failedIterations = append(failedIterations, iteration)
+				} else if console.Failures() > failures {
+					failedIterations = append(failedIterations, iteration)
+				}
+			}
+			if err := console.WriteReport(); err != nil {
+				log.Fatalf("failed to write report: %v", err)
+			}
+			if len(failedIterations) > 0 {
+				if flagset.count > 1 {
+					log.Fatalf("FAIL:  %d of the %d iterations of the suite failed: %v", len(failedIterations), flagset.count, failedIterations)
+				}
+				os.Exit(1)
+			}
+			if flagset.count > 1 {
+				log.Printf("PASS:  all %d iterations of the suite", flagset.count)
+			}
 		},
 	}
 	if err := bind(rootCmd, flagset); err != nil {
@@ -91,7 +195,7 @@ func bind(cmd *cobra.Command, flags *flags) error {
 		"i",
 		"",
 		fmt.Sprintf(
-			"the client implementation tested, accepted values are %q, %q, %q, %q, %q, %q, %q, %q, or %q",
+			"the client implementation tested, accepted values are %q, %q, %q, %q, %q, %q, %q, %q, %q, or %q",
 			connectH1,
 			connectH2,
 			connectH3,
@@ -100,12 +204,84 @@ func bind(cmd *cobra.Command, flags *flags) error {
 			connectGRPCWebH1,
 			connectGRPCWebH2,
 			connectGRPCWebH3,
+			connectGRPCWebEnvoy,
 			grpcGo,
 		),
 	)
-	cmd.Flags().StringVar(&flags.certFile, certFlagName, "", "path to the TLS cert file")
-	cmd.Flags().StringVar(&flags.keyFile, keyFlagName, "", "path to the TLS key file")
-	for _, requiredFlag := range []string{portFlagName, implementationFlagName, certFlagName, keyFlagName} {
+	cmd.Flags().BoolVar(&flags.insecure, insecureFlagName, false, "connect without TLS, using h2c for HTTP/2")
+	cmd.Flags().StringVar(&flags.caCertFile, caCertFlagName, "cert/CrosstestCA.crt", "path to the CA cert file used to verify the server")
+	cmd.Flags().StringVar(&flags.certFile, certFlagName, "", "path to the TLS cert file, for mutual TLS")
+	cmd.Flags().StringVar(&flags.keyFile, keyFlagName, "", "path to the TLS key file, for mutual TLS")
+	cmd.Flags().IntVar(&flags.soakIterations, soakIterationsFlagName, 10, "the number of iterations of the soak tests")
+	cmd.Flags().IntVar(&flags.soakMaxFailures, soakMaxFailuresFlagName, 0, "the number of failed iterations tolerated by the soak tests")
+	cmd.Flags().StringVar(&flags.reportJSONFile, reportJSONFlagName, "", "path to write a JSON report of the test results to")
+	cmd.Flags().BoolVarP(&flags.verbose, verboseFlagName, "v", false, "log the duration of each test, and the message sizes of unary calls")
+	cmd.Flags().IntVar(&flags.parallelism, parallelismFlagName, 1, "the number of tests run concurrently")
+	cmd.Flags().IntVar(&flags.count, countFlagName, 1, "the number of times the whole suite is run, sequentially, to flush out intermittent failures")
+	cmd.Flags().StringSliceVar(
+		&flags.acceptEncodings,
+		acceptEncodingFlagName,
+		[]string{"snappy", compression.Brotli, compression.Zstd},
+		"the compression algorithms accepted by the compression negotiation test, in order of preference, in addition to gzip",
+	)
+	cmd.Flags().StringVar(
+		&flags.authToken,
+		authTokenFlagName,
+		"",
+		"the bearer token required by the server, attached to all requests and checked by the per-RPC credentials test if set",
+	)
+	cmd.Flags().StringVar(
+		&flags.unixSocket,
+		unixSocketFlagName,
+		"",
+		"the path of a unix domain socket the test server listens on, dialed instead of the host and port if set",
+	)
+	cmd.Flags().DurationVar(
+		&flags.keepalive.interval,
+		keepaliveIntervalFlagName,
+		0,
+		"the idle time after which HTTP/2 clients ping the server, at least 10s for grpc-go, enabling the keepalive test if set",
+	)
+	cmd.Flags().DurationVar(
+		&flags.keepalive.timeout,
+		keepaliveTimeoutFlagName,
+		15*time.Second,
+		"the time HTTP/2 clients wait for a keepalive ping response before closing the connection",
+	)
+	cmd.Flags().DurationVar(
+		&flags.maxConnectionAge,
+		maxConnectionAgeFlagName,
+		0,
+		"the --max-connection-age of the server, enabling the max connection age test if set",
+	)
+	cmd.Flags().IntVar(
+		&flags.maxConcurrentStreams,
+		maxConcurrentStreamsFlagName,
+		0,
+		"the --max-concurrent-streams of the server, enabling the max concurrent streams test if set",
+	)
+	cmd.Flags().DurationVar(
+		&flags.streamMessageDelay,
+		streamMessageDelayFlagName,
+		0,
+		"the delay the server waits before sending each response of the server streaming and ping-pong tests, to exercise slow streams",
+	)
+	cmd.Flags().IntVar(&flags.retryMaxRetries, retryMaxRetriesFlagName, 3, "the maximum number of retries of the retry test")
+	cmd.Flags().DurationVar(&flags.retryBackoff, retryBackoffFlagName, 10*time.Millisecond, "the backoff before the first retry of the retry test, doubled for each retry")
+	cmd.Flags().StringVar(
+		&flags.tlsMinVersion,
+		tlsMinVersionFlagName,
+		"1.2",
+		"the minimum TLS version of the client, 1.2 or 1.3, enabling the TLS version negotiation test if 1.3, which expects the same --tls-min-version of the server",
+	)
+	cmd.Flags().DurationVar(
+		&flags.requestTimeout,
+		requestTimeoutFlagName,
+		0,
+		"the timeout of the calls without a deadline, after which they fail with the status DEADLINE_EXCEEDED, so that a hung call fails its test instead of blocking the run, no timeout if zero",
+	)
+	cmd.Flags().IntVar(&flags.gzipLevel, gzipLevelFlagName, compressgzip.DefaultCompression, "the level of the gzip compressed requests, from 1 for the best speed to 9 for the best compression")
+	for _, requiredFlag := range []string{portFlagName, implementationFlagName} {
 		if err := cmd.MarkFlagRequired(requiredFlag); err != nil {
 			return err
 		}
@@ -113,62 +289,206 @@ func bind(cmd *cobra.Command, flags *flags) error {
 	return nil
 }
 
-func run(flags *flags) {
+// checkFlags returns an error for the flags that are invalid regardless of the
+// server, before any iteration of the suite runs.
+func checkFlags(flags *flags) error {
+	if flags.count < 1 {
+		return fmt.Errorf("the --%s flag must be at least 1", countFlagName)
+	}
+	if flags.parallelism < 1 {
+		return fmt.Errorf("the --%s flag must be at least 1", parallelismFlagName)
+	}
+	switch flags.implementation {
+	case connectH1, connectH2, connectGRPCH1, connectGRPCH2, connectGRPCWebH1, connectGRPCWebH2, connectGRPCWebEnvoy, grpcGo:
+	case connectH3, connectGRPCWebH3:
+		if flags.insecure {
+			return fmt.Errorf("HTTP/3 requires TLS, the --%s flag can't be used with %q", insecureFlagName, flags.implementation)
+		}
+		if flags.unixSocket != "" {
+			return fmt.Errorf("HTTP/3 runs over UDP, the --%s flag can't be used with %q", unixSocketFlagName, flags.implementation)
+		}
+	default:
+		return fmt.Errorf("the --%s or -i flag is invalid: %q", implementationFlagName, flags.implementation)
+	}
+	if err := compression.CheckGzipLevel(flags.gzipLevel); err != nil {
+		return fmt.Errorf("invalid --%s flag: %w", gzipLevelFlagName, err)
+	}
+	if _, err := interop.ParseTLSVersion(flags.tlsMinVersion); err != nil {
+		return fmt.Errorf("invalid --%s flag: %w", tlsMinVersionFlagName, err)
+	}
+	return nil
+}
+
+// run runs an iteration of the suite. The test cases report their own
+// failures, so it only returns an error if the suite can't run.
+func run(flags *flags) error {
+	tlsMinVersion, err := interop.ParseTLSVersion(flags.tlsMinVersion)
+	if err != nil {
+		return fmt.Errorf("invalid --%s flag: %w", tlsMinVersionFlagName, err)
+	}
+	runner := newTestRunner(flags.parallelism)
 	// tests for grpc client
 	if flags.implementation == grpcGo {
-		transportCredentials := credentials.NewTLS(newTLSConfig(flags.certFile, flags.keyFile))
-		clientConn, err := grpc.Dial(
-			net.JoinHostPort(flags.host, flags.port),
-			grpc.WithTransportCredentials(transportCredentials),
-		)
+		if flags.gzipLevel != compressgzip.DefaultCompression {
+			if err := gzip.SetLevel(flags.gzipLevel); err != nil {
+				return fmt.Errorf("invalid --%s flag: %w", gzipLevelFlagName, err)
+			}
+		}
+		transportCredentials := insecure.NewCredentials()
+		if !flags.insecure {
+			tlsConfig, err := clienttransport.NewTLSConfig(flags.caCertFile, flags.certFile, flags.keyFile, tlsMinVersion)
+			if err != nil {
+				return fmt.Errorf("failed to create TLS config: %w", err)
+			}
+			transportCredentials = credentials.NewTLS(tlsConfig)
+		}
+		dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+		if flags.verbose {
+			dialOptions = append(dialOptions, grpc.WithUnaryInterceptor(interopgrpc.SizeLoggingUnaryClientInterceptor))
+		}
+		if flags.requestTimeout > 0 {
+			unary, stream := interopgrpc.NewTimeoutInterceptors(flags.requestTimeout)
+			dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(unary), grpc.WithChainStreamInterceptor(stream))
+		}
+		target := net.JoinHostPort(flags.host, flags.port)
+		if flags.unixSocket != "" {
+			target = "unix:" + flags.unixSocket
+		}
+		// dial a connection without the bearer token for the per-RPC credentials
+		// test first
+		unauthenticatedClientConn, err := grpc.Dial(target, dialOptions...)
 		if err != nil {
-			log.Fatalf("failed grpc dial: %v", err)
+			return fmt.Errorf("failed grpc dial: %w", err)
+		}
+		defer unauthenticatedClientConn.Close()
+		if flags.keepalive.interval > 0 {
+			dialOptions = append(dialOptions, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                flags.keepalive.interval,
+				Timeout:             flags.keepalive.timeout,
+				PermitWithoutStream: true,
+			}))
+		}
+		if flags.authToken != "" {
+			dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(interopgrpc.NewBearerTokenCredentials(flags.authToken)))
+		}
+		clientConn, err := grpc.Dial(target, dialOptions...)
+		if err != nil {
+			return fmt.Errorf("failed grpc dial: %w", err)
 		}
 		defer clientConn.Close()
 		unresolvableClientConn, err := grpc.Dial(
 			"unresolvable-host.some.domain",
-			grpc.WithTransportCredentials(transportCredentials),
+			dialOptions...,
 		)
 		if err != nil {
-			log.Fatalf("failed grpc dial: %v", err)
+			return fmt.Errorf("failed grpc dial: %w", err)
 		}
 		defer unresolvableClientConn.Close()
-		testGrpc(clientConn, unresolvableClientConn)
-		return
+		// dial a connection with a custom user-agent for the user-agent test
+		userAgent := "connect-crosstest"
+		userAgentClientConn, err := grpc.Dial(target, append(dialOptions, grpc.WithUserAgent(userAgent))...)
+		if err != nil {
+			return fmt.Errorf("failed grpc dial: %w", err)
+		}
+		defer userAgentClientConn.Close()
+		testGrpc(runner, clientConn, unresolvableClientConn, flags.streamMessageDelay)
+		if flags.maxConnectionAge > 0 {
+			runner.runSerial(func() {
+				interopgrpc.DoMaxConnectionAge(console.NewTB("max_connection_age"), testgrpc.NewTestServiceClient(clientConn), flags.maxConnectionAge)
+			})
+		}
+		if flags.keepalive.interval > 0 {
+			runner.runSerial(func() {
+				interopgrpc.DoKeepaliveIdleConnection(console.NewTB("keepalive_idle_connection"), testgrpc.NewTestServiceClient(clientConn), flags.keepalive.interval)
+			})
+		}
+		if flags.maxConcurrentStreams > 0 {
+			runner.run(func() {
+				interopgrpc.DoMaxConcurrentStreams(console.NewTB("max_concurrent_streams"), testgrpc.NewTestServiceClient(clientConn), flags.maxConcurrentStreams)
+			})
+		}
+		runner.run(func() {
+			interopgrpc.DoUnaryCallWithCustomUserAgent(
+				console.NewTB("unary_call_with_custom_user_agent"),
+				testgrpc.NewTestServiceClient(clientConn),
+				testgrpc.NewTestServiceClient(userAgentClientConn),
+				userAgent,
+			)
+		})
+		if flags.authToken != "" {
+			runner.run(func() {
+				interopgrpc.DoPerRPCCredentials(
+					console.NewTB("per_rpc_creds"),
+					testgrpc.NewTestServiceClient(clientConn),
+					testgrpc.NewTestServiceClient(unauthenticatedClientConn),
+					flags.authToken,
+				)
+			})
+		}
+		runner.wait()
+		return nil
 	}
 
 	// tests for connect clients
-	serverURL, err := url.ParseRequestURI("https://" + net.JoinHostPort(flags.host, flags.port))
+	scheme := "https://"
+	var tlsConfig *tls.Config
+	if flags.insecure {
+		scheme = "http://"
+	} else {
+		tlsConfig, err = clienttransport.NewTLSConfig(flags.caCertFile, flags.certFile, flags.keyFile, tlsMinVersion)
+		if err != nil {
+			return fmt.Errorf("failed to create TLS config: %w", err)
+		}
+	}
+	serverURL, err := url.ParseRequestURI(scheme + net.JoinHostPort(flags.host, flags.port))
 	if err != nil {
-		log.Fatalf("invalid url: %s", "https://"+net.JoinHostPort(flags.host, flags.port))
+		return fmt.Errorf("invalid url: %s", scheme+net.JoinHostPort(flags.host, flags.port))
 	}
-	tlsConfig := newTLSConfig(flags.certFile, flags.keyFile)
-	// create transport base on HTTP protocol of the implementation
-	var transport http.RoundTripper
-	switch flags.implementation {
-	case connectH1, connectGRPCH1, connectGRPCWebH1:
-		transport = &http.Transport{
-			TLSClientConfig: tlsConfig,
+	var dialer *unixSocketDialer
+	if flags.unixSocket != "" {
+		dialer = &unixSocketDialer{
+			serverAddr: serverURL.Host,
+			path:       flags.unixSocket,
 		}
-	case connectGRPCH2, connectH2, connectGRPCWebH2:
-		transport = &http2.Transport{
-			TLSClientConfig: tlsConfig,
-		}
-	case connectH3, connectGRPCWebH3:
-		transport = &http3.RoundTripper{
-			TLSClientConfig: tlsConfig,
-		}
-	default:
-		log.Fatalf(`the --implementation or -i flag is invalid"`)
 	}
+	transport := newTransport(flags.implementation, tlsConfig, dialer, flags.keepalive, false)
 	// create client options base on protocol of the implementation
 	var clientOptions []connect.ClientOption
 	switch flags.implementation {
 	case connectGRPCH1, connectGRPCH2:
 		clientOptions = append(clientOptions, connect.WithGRPC())
-	case connectGRPCWebH1, connectGRPCWebH2, connectGRPCWebH3:
+	case connectGRPCWebH1, connectGRPCWebH2, connectGRPCWebH3, connectGRPCWebEnvoy:
 		clientOptions = append(clientOptions, connect.WithGRPCWeb())
 	}
+	if flags.verbose {
+		clientOptions = append(clientOptions, connect.WithInterceptors(interopconnect.NewSizeLoggingInterceptor()))
+	}
+	if flags.requestTimeout > 0 {
+		clientOptions = append(clientOptions, connect.WithInterceptors(interopconnect.NewTimeoutInterceptor(flags.requestTimeout)))
+	}
+	// keep the gzip registration at the level of the flag apart, so that the
+	// clients registering gzip themselves replace it instead of adding another
+	var gzipLevelOptions []connect.ClientOption
+	if flags.gzipLevel != compressgzip.DefaultCompression {
+		gzipLevelOption, err := compression.WithAcceptGzipLevel(flags.gzipLevel)
+		if err != nil {
+			return fmt.Errorf("invalid --%s flag: %w", gzipLevelFlagName, err)
+		}
+		gzipLevelOptions = append(gzipLevelOptions, gzipLevelOption)
+	}
+	// create a client without the bearer token for the per-RPC credentials test,
+	// before adding the token to the client options
+	unauthenticatedClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		connect.WithClientOptions(gzipLevelOptions...),
+	)
+	if flags.authToken != "" {
+		clientOptions = append(clientOptions, connect.WithInterceptors(interopconnect.NewBearerTokenInterceptor(flags.authToken)))
+	}
+	clientOptionsWithoutGzipLevel := clientOptions
+	clientOptions = append(clientOptions, gzipLevelOptions...)
 	// create test clients using the transport and client options
 	uncompressedClient := testingconnect.NewTestServiceClient(
 		&http.Client{Transport: transport},
@@ -185,6 +505,174 @@ func run(flags *flags) {
 		serverURL.String(),
 		clientOptions...,
 	)
+	// wrap the transport to count connections for the pick first test
+	connCountingTransport := interopconnect.NewConnCountingTransport(transport)
+	pickFirstClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: connCountingTransport},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// create a transport queueing the streams over the limit of the server for
+	// the max concurrent streams test, since streams sent on a new connection
+	// before the server's settings are received may be refused, and can't be
+	// retried once their request body is written
+	maxStreamsTransport := interopconnect.NewConnCountingTransport(
+		newTransport(flags.implementation, tlsConfig, dialer, flags.keepalive, true),
+	)
+	maxStreamsClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: maxStreamsTransport},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// create a new transport for each client of the channel soak test
+	soakClientOptions := connect.WithClientOptions(clientOptions...)
+	newSoakClient := func() (testingconnect.TestServiceClient, func()) {
+		transport := newTransport(flags.implementation, tlsConfig, dialer, flags.keepalive, false)
+		client := testingconnect.NewTestServiceClient(
+			&http.Client{Transport: transport},
+			serverURL.String(),
+			soakClientOptions,
+		)
+		return client, func() { closeTransport(transport) }
+	}
+	// add a read limit to create a size limited client
+	limitedClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		connect.WithReadMaxBytes(clientReadMaxBytes),
+	)
+	// wrap the transport to record responses for the trailers-only and error JSON tests
+	recordingTransport := interopconnect.NewResponseRecordingTransport(transport)
+	recordingClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: recordingTransport},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// add the JSON codec option to create JSON client
+	jsonClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		connect.WithProtoJSON(),
+	)
+	// add a user-agent interceptor to create a client with a custom user-agent,
+	// which contains the connect-go version like the default one does
+	userAgent := "connect-crosstest connect-go/" + connect.Version
+	userAgentClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		connect.WithInterceptors(interopconnect.NewUserAgentInterceptor(userAgent)),
+	)
+	// add zstd compression options to create zstd compressed client
+	zstdClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		compression.WithAcceptZstd(),
+		connect.WithSendCompression(compression.Zstd),
+	)
+	// add deflate compression options to create deflate compressed client
+	deflateClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		compression.WithAcceptDeflate(),
+		connect.WithSendCompression(compression.Deflate),
+	)
+	// add brotli compression options to create brotli compressed client
+	brotliClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		compression.WithAcceptBrotli(),
+		connect.WithSendCompression(compression.Brotli),
+	)
+	// add the accepted compression options to create a client for the compression
+	// negotiation test
+	negotiationClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptionsWithoutGzipLevel...),
+		compression.WithAccept(flags.acceptEncodings...),
+	)
+	// wrap the transport to claim uncompressed requests are compressed for the
+	// compression mismatch test
+	mismatchClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: interopconnect.NewCompressionMismatchTransport(transport)},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// wrap the transport to convert requests and responses to the text format
+	// of gRPC-Web, which base64-encodes the frames, for the gRPC-Web text tests
+	textClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: interopconnect.NewGRPCWebTextTransport(transport)},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// add a retry interceptor to create a client retrying unary calls for the
+	// retry test, since connect-go doesn't retry calls itself
+	retryClient := testingconnect.NewTestServiceClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		connect.WithClientOptions(clientOptions...),
+		connect.WithInterceptors(interopconnect.NewRetryInterceptor(
+			flags.retryMaxRetries,
+			flags.retryBackoff,
+			interopconnect.UnaryCallProcedure,
+		)),
+	)
+	// wrap the transport to count the bytes of the requests compressed with gzip
+	// at the best speed and at the best compression for the gzip levels test
+	requestCountingTransport := interopconnect.NewRequestBytesCountingTransport(transport)
+	newGzipLevelClient := func(level int) (testingconnect.TestServiceClient, error) {
+		gzipLevelOption, err := compression.WithAcceptGzipLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		return testingconnect.NewTestServiceClient(
+			&http.Client{Transport: requestCountingTransport},
+			serverURL.String(),
+			connect.WithClientOptions(clientOptionsWithoutGzipLevel...),
+			gzipLevelOption,
+			connect.WithSendGzip(),
+		), nil
+	}
+	bestSpeedClient, err := newGzipLevelClient(compressgzip.BestSpeed)
+	if err != nil {
+		return fmt.Errorf("failed to create the best speed gzip client: %w", err)
+	}
+	bestCompressionClient, err := newGzipLevelClient(compressgzip.BestCompression)
+	if err != nil {
+		return fmt.Errorf("failed to create the best compression gzip client: %w", err)
+	}
+	// create clients connecting to the IPv4 and IPv6 loopback addresses for the
+	// peer address test, which verify the certificate of the server for localhost
+	newLoopbackClient := func(ip string) testingconnect.TestServiceClient {
+		var loopbackTLSConfig *tls.Config
+		if tlsConfig != nil {
+			loopbackTLSConfig = tlsConfig.Clone()
+			loopbackTLSConfig.ServerName = "localhost"
+		}
+		return testingconnect.NewTestServiceClient(
+			&http.Client{Transport: newTransport(flags.implementation, loopbackTLSConfig, nil, flags.keepalive, false)},
+			scheme+net.JoinHostPort(ip, flags.port),
+			clientOptions...,
+		)
+	}
+	// create a client for the gRPC health checking service
+	healthClient := healthv1connect.NewHealthClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		clientOptions...,
+	)
+	// create a client for the gRPC server reflection service
+	reflectionClient := interopconnect.NewServerReflectionClient(
+		&http.Client{Transport: transport},
+		serverURL.String(),
+		clientOptions...,
+	)
 	// add compress options to create compressed client
 	clientOptions = append(clientOptions, connect.WithSendGzip())
 	compressedClient := testingconnect.NewTestServiceClient(
@@ -193,133 +681,685 @@ func run(flags *flags) {
 		clientOptions...,
 	)
 
+	variants := []clientVariant{
+		{name: "uncompressed", client: uncompressedClient},
+		{name: "compressed", client: compressedClient},
+	}
 	// run tests base on the implementation
 	switch flags.implementation {
 	// We skipped those streaming tests for http 1 test
 	case connectH1, connectGRPCH1, connectGRPCWebH1:
-		for _, client := range []testingconnect.TestServiceClient{uncompressedClient, compressedClient} {
-			testConnectUnary(client)
-			testConnectServerStreaming(client)
+		for _, variant := range variants {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, variant, allTestCases)
 		}
-		testConnectSpecialClients(unresolvableClient, unimplementedClient)
+		testConnectCompression(
+			runner,
+			uncompressedClient,
+			compressedClient,
+			zstdClient,
+			deflateClient,
+			brotliClient,
+			negotiationClient,
+			mismatchClient,
+			flags.acceptEncodings,
+		)
+		runner.runSerial(func() {
+			interopconnect.DoPickFirstUnary(console.NewTB("pick_first_unary"), pickFirstClient, connCountingTransport)
+		})
+		testConnectSpecialClients(runner, unresolvableClient, unimplementedClient)
 	case connectGRPCH2, connectH2, connectGRPCWebH2:
-		for _, client := range []testingconnect.TestServiceClient{uncompressedClient, compressedClient} {
-			testConnectUnary(client)
-			testConnectServerStreaming(client)
-			testConnectClientStreaming(client)
-			testConnectBidiStreaming(client)
-			interopconnect.DoTimeoutOnSleepingServer(console.NewTB(), client)
-		}
-		testConnectSpecialClients(unresolvableClient, unimplementedClient)
+		for _, variant := range variants {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, variant, allTestCases)
+		}
+		testConnectCompression(
+			runner,
+			uncompressedClient,
+			compressedClient,
+			zstdClient,
+			deflateClient,
+			brotliClient,
+			negotiationClient,
+			mismatchClient,
+			flags.acceptEncodings,
+		)
+		testConnectStreamingCompression(runner, uncompressedClient, compressedClient)
+		runner.runSerial(func() {
+			interopconnect.DoPickFirstUnary(console.NewTB("pick_first_unary"), pickFirstClient, connCountingTransport)
+		})
+		testConnectSpecialClients(runner, unresolvableClient, unimplementedClient)
 	case connectH3:
-		for _, client := range []testingconnect.TestServiceClient{uncompressedClient, compressedClient} {
-			testConnectUnary(client)
-			testConnectServerStreaming(client)
-			testConnectClientStreaming(client)
-			testConnectBidiStreaming(client)
-			// skipped the DoTimeoutOnSleepingServer test as quic-go wrapped the context error,
-			// see https://github.com/lucas-clemente/quic-go/blob/6fbc6d951a4005d7d9d086118e1572b9e8ff9851/http3/client.go#L276-L283
-		}
-		testConnectSpecialClients(unresolvableClient, unimplementedClient)
+		for _, variant := range variants {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, variant, allTestCases)
+		}
+		testConnectCompression(
+			runner,
+			uncompressedClient,
+			compressedClient,
+			zstdClient,
+			deflateClient,
+			brotliClient,
+			negotiationClient,
+			mismatchClient,
+			flags.acceptEncodings,
+		)
+		testConnectStreamingCompression(runner, uncompressedClient, compressedClient)
+		// skipped the DoPickFirstUnary test as the quic-go transport does not report
+		// connections through httptrace
+		testConnectSpecialClients(runner, unresolvableClient, unimplementedClient)
 	case connectGRPCWebH3:
-		for _, client := range []testingconnect.TestServiceClient{uncompressedClient, compressedClient} {
-			// For tests that depend on trailers, we only run them for HTTP2, since the HTTP3 client
-			// does not yet have trailers support https://github.com/lucas-clemente/quic-go/issues/2266
-			// Once trailer support is available, they will be renabled.
-			interopconnect.DoEmptyUnaryCall(console.NewTB(), client)
-			interopconnect.DoLargeUnaryCall(console.NewTB(), client)
-			interopconnect.DoClientStreaming(console.NewTB(), client)
-			interopconnect.DoServerStreaming(console.NewTB(), client)
-			interopconnect.DoPingPong(console.NewTB(), client)
+		for _, variant := range variants {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, variant, allTestCases)
 		}
+	case connectGRPCWebEnvoy:
+		// the other tests depend on the Connect server, or on client or bidi
+		// streaming, which gRPC-Web over HTTP/1.1 doesn't support
+		for _, variant := range variants {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, variant, allTestCases)
+		}
+		// Envoy's grpc_web filter also supports the gRPC-Web text format
+		runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, clientVariant{name: "text", client: textClient}, unaryTestCases)
+		runner.wait()
+		return nil
+	}
+	// run the unary tests with the JSON codec for the Connect protocol only, since
+	// the grpc-go server only supports the binary Protobuf codec. Over HTTP/1.1,
+	// this covers Connect's unary path with a plain HTTP/1.1 transport.
+	switch flags.implementation {
+	case connectH1, connectH2, connectH3:
+		runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, clientVariant{name: "json", client: jsonClient}, unaryTestCases)
+	}
+	// run the error JSON test for the Connect protocol only, since gRPC and
+	// gRPC-Web send errors in headers or trailers, and the content type test,
+	// since they only support a single content type per codec
+	switch flags.implementation {
+	case connectH1, connectH2, connectH3:
+		runner.run(func() {
+			interopconnect.DoConnectErrorJSON(console.NewTB("connect_error_json"), recordingClient, recordingTransport)
+		})
+		runner.run(func() {
+			interopconnect.DoConnectContentType(console.NewTB("connect_content_type"), transport, serverURL.String(), clientOptions...)
+		})
+	}
+	// run the unary tests with the gRPC-Web text format where the server supports
+	// it, such as behind Envoy, since connect-go servers only support the binary
+	// format
+	switch flags.implementation {
+	case connectGRPCWebH1, connectGRPCWebH2:
+		supportsText, err := interopconnect.SupportsGRPCWebText(context.Background(), &http.Client{Transport: transport}, serverURL.String())
+		if err != nil {
+			return fmt.Errorf("failed to probe for gRPC-Web text support: %w", err)
+		}
+		if supportsText {
+			runClientTestCases(runner, flags.implementation, flags.streamMessageDelay, clientVariant{name: "text", client: textClient}, unaryTestCases)
+		} else {
+			log.Printf("SKIP:  gRPC-Web text tests, the server doesn't support the gRPC-Web text format")
+		}
+	}
+	// run the long trailers tests except for gRPC over HTTP/1.1, since net/http
+	// clients limit the trailers of HTTP/1.1 responses to the size of the read
+	// buffer of the connection, 4 KiB by default
+	switch flags.implementation {
+	case connectGRPCH1:
+		log.Printf("SKIP:  many long trailers and large binary trailer tests, net/http limits HTTP/1.1 trailers to 4 KiB")
+	default:
+		runner.run(func() { interopconnect.DoManyLongTrailers(console.NewTB("many_long_trailers"), uncompressedClient) })
+		runner.run(func() {
+			interopconnect.DoLargeMetadataBinaryTrailer(console.NewTB("large_metadata_binary_trailer"), uncompressedClient)
+		})
+	}
+	// run the trailers-only test for the gRPC protocol only, since gRPC-Web and
+	// Connect don't send trailers as HTTP trailers, and only where the server
+	// sends trailers-only responses, which connect-go servers can't
+	switch flags.implementation {
+	case connectGRPCH1, connectGRPCH2:
+		supportsTrailersOnly, err := interopconnect.SupportsTrailersOnly(context.Background(), &http.Client{Transport: transport}, serverURL.String())
+		if err != nil {
+			return fmt.Errorf("failed to probe for trailers-only support: %w", err)
+		}
+		if supportsTrailersOnly {
+			runner.run(func() {
+				interopconnect.DoTrailersOnly(console.NewTB("trailers_only"), recordingClient, recordingTransport)
+			})
+		} else {
+			log.Printf("SKIP:  trailers-only test, the server sends the status as HTTP trailers")
+		}
+	}
+	// run the server reflection test where bidi streaming is supported, since the
+	// reflection service is a bidi streaming service
+	switch flags.implementation {
+	case connectGRPCH2, connectH2, connectGRPCWebH2, connectH3:
+		runner.run(func() { interopconnect.DoServerReflection(console.NewTB("server_reflection"), reflectionClient) })
+	}
+	// run the max connection age test where connections are counted, which the
+	// quic-go transport doesn't report
+	switch flags.implementation {
+	case connectH1, connectGRPCH1, connectGRPCWebH1, connectGRPCH2, connectH2, connectGRPCWebH2:
+		if flags.maxConnectionAge > 0 {
+			runner.runSerial(func() {
+				interopconnect.DoMaxConnectionAge(console.NewTB("max_connection_age"), pickFirstClient, connCountingTransport, flags.maxConnectionAge)
+			})
+		}
+	}
+	// run the keepalive test over HTTP/2 only, since the keepalive pings are sent
+	// by the HTTP/2 transport
+	switch flags.implementation {
+	case connectGRPCH2, connectH2, connectGRPCWebH2:
+		if flags.keepalive.interval > 0 {
+			runner.runSerial(func() {
+				interopconnect.DoKeepaliveIdleConnection(console.NewTB("keepalive_idle_connection"), uncompressedClient, flags.keepalive.interval)
+			})
+		}
+	}
+	// run the max concurrent streams test over HTTP/2 only, since the limit is
+	// set by the HTTP/2 server and the test needs bidi streaming, serially, since
+	// it counts the new connections opened for the streams
+	switch flags.implementation {
+	case connectGRPCH2, connectH2, connectGRPCWebH2:
+		if flags.maxConcurrentStreams > 0 {
+			runner.runSerial(func() {
+				interopconnect.DoMaxConcurrentStreams(console.NewTB("max_concurrent_streams"), maxStreamsClient, maxStreamsTransport, flags.maxConcurrentStreams)
+			})
+		}
+	}
+	// run the backpressure test serially, since it counts the goroutines of the
+	// process
+	runner.runSerial(func() {
+		interopconnect.DoServerStreamingBackpressureWithCancel(console.NewTB("server_streaming_backpressure_with_cancel"), uncompressedClient)
+	})
+	// run the ALPN test over TLS, except over HTTP/3, whose connections the
+	// quic-go transport doesn't report through httptrace
+	if tlsConfig != nil {
+		switch flags.implementation {
+		case connectH1, connectGRPCH1, connectGRPCWebH1:
+			runner.run(func() { interopconnect.DoALPNProtocol(console.NewTB("alpn_protocol"), uncompressedClient, "http/1.1") })
+		case connectGRPCH2, connectH2, connectGRPCWebH2:
+			runner.run(func() { interopconnect.DoALPNProtocol(console.NewTB("alpn_protocol"), uncompressedClient, "h2") })
+		}
+	}
+	// run the TLS version negotiation test when the server requires TLS 1.3,
+	// except over HTTP/3, which always uses TLS 1.3
+	switch flags.implementation {
+	case connectH1, connectGRPCH1, connectGRPCWebH1, connectGRPCH2, connectH2, connectGRPCWebH2:
+		if tlsConfig != nil && tlsMinVersion == tls.VersionTLS13 {
+			tls12Config := tlsConfig.Clone()
+			tls12Config.MinVersion = tls.VersionTLS12
+			tls12Config.MaxVersion = tls.VersionTLS12
+			tls12Client := testingconnect.NewTestServiceClient(
+				&http.Client{Transport: newTransport(flags.implementation, tls12Config, dialer, flags.keepalive, false)},
+				serverURL.String(),
+				clientOptions...,
+			)
+			runner.run(func() {
+				interopconnect.DoTLSVersionNegotiation(console.NewTB("tls_version_negotiation"), tls12Client, uncompressedClient)
+			})
+		}
+	}
+	runner.run(func() { interopconnect.DoHealthCheck(console.NewTB("health_check"), healthClient) })
+	runner.run(func() {
+		interopconnect.DoUnaryCallWithCustomUserAgent(console.NewTB("unary_call_with_custom_user_agent"), uncompressedClient, userAgentClient, userAgent)
+	})
+	// run the peer address test when the server is local, so that it can be
+	// reached on the loopback addresses, and not over a unix socket, whose peers
+	// have no IP address
+	if flags.unixSocket == "" && isLoopback(flags.host) {
+		ipv4Client := newLoopbackClient("127.0.0.1")
+		var ipv6Client testingconnect.TestServiceClient
+		if ipv6LoopbackAvailable() {
+			ipv6Client = newLoopbackClient("::1")
+		} else {
+			log.Printf("SKIP:  IPv6 peer address test, the IPv6 loopback address isn't available")
+		}
+		runner.run(func() { interopconnect.DoPeerAddress(console.NewTB("peer_address"), ipv4Client, ipv6Client) })
+	}
+	runner.run(func() {
+		interopconnect.DoGzipCompressionLevels(console.NewTB("gzip_compression_levels"), bestSpeedClient, bestCompressionClient, requestCountingTransport)
+	})
+	runner.run(func() {
+		interopconnect.DoRetryOnUnavailable(console.NewTB("retry_on_unavailable"), retryClient, flags.retryMaxRetries, flags.retryBackoff)
+	})
+	if flags.authToken != "" {
+		runner.run(func() {
+			interopconnect.DoPerRPCCredentials(console.NewTB("per_rpc_creds"), uncompressedClient, unauthenticatedClient, flags.authToken)
+		})
+	}
+	testConnectMessageSizeLimits(runner, uncompressedClient, limitedClient)
+	testConnectSoak(runner, uncompressedClient, newSoakClient, flags.soakIterations, flags.soakMaxFailures)
+	runner.wait()
+	return nil
+}
+
+// clientVariant is a client of the test service named after how it's
+// configured, like the compression or the codec it uses, so that the results
+// of the test cases run with each variant can be told apart.
+type clientVariant struct {
+	name   string
+	client testingconnect.TestServiceClient
+}
+
+// variantTestCaseName returns the name of the result of the test case run with
+// the client variant.
+func variantTestCaseName(name, variant string) string {
+	return name + "/" + variant
+}
+
+// apartTestCases are the test cases of the shared table that aren't run with
+// each client variant, but once with a client of their own, or only under some
+// conditions.
+var apartTestCases = map[string]bool{ // nolint:gochecknoglobals
+	"exceeds_server_message_size_limit": true,
+	"many_long_trailers":                true,
+	"large_metadata_binary_trailer":     true,
+}
+
+// http3SkippedTestCases are the test cases of the shared table skipped over
+// HTTP/3:
+//   - deadline_propagation, as quic-go reports an expired deadline as a
+//     canceled stream, which connect-go codes as unavailable
+//   - the tests bounding calls with deadlines or canceling them, as quic-go
+//     wraps the context error, see
+//     https://github.com/lucas-clemente/quic-go/blob/6fbc6d951a4005d7d9d086118e1572b9e8ff9851/http3/client.go#L276-L283
+//   - streaming_input_call_empty_payload and
+//     streaming_input_call_large_aggregate, as connect-go reads the envelope
+//     prefix with a single Read, which the quic-go request body may return
+//     short for tiny messages or long streams of small ones
+var http3SkippedTestCases = map[string]bool{ // nolint:gochecknoglobals
+	"deadline_propagation":                         true,
+	"timeout_on_sleeping_server":                   true,
+	"server_side_deadline_propagation":             true,
+	"deadline_exceeded_server_streaming":           true,
+	"cancel_after_begin_server_streaming":          true,
+	"cancel_during_server_streaming":               true,
+	"cancel_after_first_response_server_streaming": true,
+	"streaming_input_call_empty_payload":           true,
+	"streaming_input_call_large_aggregate":         true,
+}
+
+// grpcWebHTTP3TestCases are the only test cases of the shared table run with
+// gRPC-Web over HTTP/3. The tests that depend on trailers are only run over
+// HTTP/2, since the HTTP/3 client does not yet have trailers support
+// https://github.com/lucas-clemente/quic-go/issues/2266. Once trailer support
+// is available, they will be reenabled.
+var grpcWebHTTP3TestCases = map[string]bool{ // nolint:gochecknoglobals
+	"empty_unary":      true,
+	"large_unary":      true,
+	"client_streaming": true,
+	"server_streaming": true,
+	"ping_pong":        true,
+}
+
+// supportsTestCase reports whether the test case of the shared table can run
+// with the implementation.
+func supportsTestCase(implementation string, testCase interopconnect.ClientTestCase) bool {
+	switch implementation {
+	case connectH1, connectGRPCH1, connectGRPCWebH1:
+		// HTTP/1.1 doesn't support streaming the request and the response at the
+		// same time, as client and bidi streaming need
+		return testCase.StreamType&connect.StreamTypeClient == 0
+	case connectGRPCWebEnvoy:
+		return testCase.StreamType&connect.StreamTypeClient == 0 && !envoySkippedTestCases[testCase.Name]
+	case connectH3:
+		return !http3SkippedTestCases[testCase.Name]
+	case connectGRPCWebH3:
+		return grpcWebHTTP3TestCases[testCase.Name]
+	default:
+		return true
 	}
 }
 
-func testConnectUnary(client testingconnect.TestServiceClient) {
-	interopconnect.DoEmptyUnaryCall(console.NewTB(), client)
-	interopconnect.DoLargeUnaryCall(console.NewTB(), client)
-	interopconnect.DoCustomMetadataUnary(console.NewTB(), client)
-	interopconnect.DoDuplicatedCustomMetadataUnary(console.NewTB(), client)
-	interopconnect.DoStatusCodeAndMessageUnary(console.NewTB(), client)
-	interopconnect.DoSpecialStatusMessage(console.NewTB(), client)
-	interopconnect.DoUnimplementedMethod(console.NewTB(), client)
-	interopconnect.DoFailWithNonASCIIError(console.NewTB(), client)
+func allTestCases(interopconnect.ClientTestCase) bool {
+	return true
 }
 
-func testConnectServerStreaming(client testingconnect.TestServiceClient) {
-	interopconnect.DoServerStreaming(console.NewTB(), client)
-	interopconnect.DoCustomMetadataServerStreaming(console.NewTB(), client)
-	interopconnect.DoDuplicatedCustomMetadataServerStreaming(console.NewTB(), client)
-	interopconnect.DoUnimplementedServerStreamingMethod(console.NewTB(), client)
-	interopconnect.DoFailServerStreamingWithNonASCIIError(console.NewTB(), client)
+func unaryTestCases(testCase interopconnect.ClientTestCase) bool {
+	return testCase.StreamType == connect.StreamTypeUnary
 }
 
-func testConnectClientStreaming(client testingconnect.TestServiceClient) {
-	interopconnect.DoClientStreaming(console.NewTB(), client)
-	interopconnect.DoCancelAfterBegin(console.NewTB(), client)
+// runClientTestCases runs the test cases of the shared table selected by
+// include and supported by the implementation with the client variant, alone
+// for the serial ones.
+func runClientTestCases(
+	runner *testRunner,
+	implementation string,
+	streamMessageDelay time.Duration,
+	variant clientVariant,
+	include func(interopconnect.ClientTestCase) bool,
+) {
+	for _, testCase := range interopconnect.ClientTestCases(streamMessageDelay) {
+		if apartTestCases[testCase.Name] || !include(testCase) || !supportsTestCase(implementation, testCase) {
+			continue
+		}
+		testCase := testCase
+		name := variantTestCaseName(testCase.Name, variant.name)
+		run := func() { testCase.Run(console.NewTB(name), variant.client) }
+		if testCase.Serial {
+			runner.runSerial(run)
+		} else {
+			runner.run(run)
+		}
+	}
 }
 
-func testConnectBidiStreaming(client testingconnect.TestServiceClient) {
-	interopconnect.DoPingPong(console.NewTB(), client)
-	interopconnect.DoEmptyStream(console.NewTB(), client)
-	interopconnect.DoCancelAfterFirstResponse(console.NewTB(), client)
-	interopconnect.DoCustomMetadataFullDuplex(console.NewTB(), client)
-	interopconnect.DoDuplicatedCustomMetadataFullDuplex(console.NewTB(), client)
-	interopconnect.DoStatusCodeAndMessageFullDuplex(console.NewTB(), client)
+// envoySkippedTestCases are the test cases of the shared table skipped through
+// Envoy. oversized_metadata is left out as Envoy rejects requests with more than
+// 60 KiB of headers with its own HTTP response, and many_trailers as Envoy
+// limits responses to 100 headers or trailers.
+var envoySkippedTestCases = map[string]bool{ // nolint:gochecknoglobals
+	"oversized_metadata": true,
+	"many_trailers":      true,
+}
+
+func testConnectCompression(
+	runner *testRunner,
+	uncompressedClient testingconnect.TestServiceClient,
+	compressedClient testingconnect.TestServiceClient,
+	zstdClient testingconnect.TestServiceClient,
+	deflateClient testingconnect.TestServiceClient,
+	brotliClient testingconnect.TestServiceClient,
+	negotiationClient testingconnect.TestServiceClient,
+	mismatchClient testingconnect.TestServiceClient,
+	acceptEncodings []string,
+) {
+	runner.run(func() {
+		interopconnect.DoClientCompressedUnary(console.NewTB("client_compressed_unary"), uncompressedClient, compressedClient)
+	})
+	runner.run(func() {
+		interopconnect.DoUnaryWithCompressedEmptyMessage(console.NewTB("unary_with_compressed_empty_message"), compressedClient)
+	})
+	runner.run(func() {
+		interopconnect.DoServerCompressedUnary(console.NewTB("server_compressed_unary"), compressedClient)
+	})
+	runner.run(func() {
+		interopconnect.DoServerCompressedStreaming(console.NewTB("server_compressed_streaming"), compressedClient)
+	})
+	runner.run(func() { interopconnect.DoZstdCompressedUnary(console.NewTB("zstd_compressed_unary"), zstdClient) })
+	runner.run(func() {
+		interopconnect.DoDeflateCompressedUnary(console.NewTB("deflate_compressed_unary"), deflateClient)
+	})
+	runner.run(func() { interopconnect.DoBrotliCompressedUnary(console.NewTB("brotli_compressed_unary"), brotliClient) })
+	runner.run(func() {
+		interopconnect.DoCompressionNegotiation(console.NewTB("compression_negotiation"), negotiationClient, acceptEncodings)
+	})
+	runner.run(func() {
+		interopconnect.DoUnaryWithRequestCompressionMismatch(console.NewTB("unary_with_request_compression_mismatch"), mismatchClient)
+	})
+}
+
+func testConnectStreamingCompression(
+	runner *testRunner,
+	uncompressedClient testingconnect.TestServiceClient,
+	compressedClient testingconnect.TestServiceClient,
+) {
+	runner.run(func() {
+		interopconnect.DoClientCompressedStreaming(console.NewTB("client_compressed_streaming"), uncompressedClient, compressedClient)
+	})
 }
 
 func testConnectSpecialClients(
+	runner *testRunner,
 	unresolvableClient testingconnect.TestServiceClient,
 	unimplementedClient testingconnect.UnimplementedServiceClient,
 ) {
-	interopconnect.DoUnresolvableHost(console.NewTB(), unresolvableClient)
-	interopconnect.DoUnimplementedService(console.NewTB(), unimplementedClient)
-	interopconnect.DoUnimplementedServerStreamingService(console.NewTB(), unimplementedClient)
+	runner.run(func() { interopconnect.DoUnresolvableHost(console.NewTB("unresolvable_host"), unresolvableClient) })
+	runner.run(func() {
+		interopconnect.DoUnimplementedService(console.NewTB("unimplemented_service"), unimplementedClient)
+	})
+	runner.run(func() {
+		interopconnect.DoUnimplementedServerStreamingService(console.NewTB("unimplemented_server_streaming_service"), unimplementedClient)
+	})
+}
+
+func testConnectMessageSizeLimits(
+	runner *testRunner,
+	client testingconnect.TestServiceClient,
+	limitedClient testingconnect.TestServiceClient,
+) {
+	runner.run(func() {
+		interopconnect.DoExceedsMessageSizeLimit(console.NewTB("exceeds_message_size_limit"), limitedClient, clientReadMaxBytes)
+	})
+	runner.run(func() {
+		interopconnect.DoExceedsServerMessageSizeLimit(console.NewTB("exceeds_server_message_size_limit"), client)
+	})
 }
 
-func testGrpc(clientConn *grpc.ClientConn, unresolvableClientConn *grpc.ClientConn) {
+func testConnectSoak(
+	runner *testRunner,
+	client testingconnect.TestServiceClient,
+	newClient func() (testingconnect.TestServiceClient, func()),
+	iterations int,
+	maxFailures int,
+) {
+	runner.runSerial(func() {
+		interopconnect.DoRPCSoak(console.NewTB("rpc_soak"), client, iterations, maxFailures, soakPerIterationTimeout)
+	})
+	runner.runSerial(func() {
+		interopconnect.DoChannelSoak(console.NewTB("channel_soak"), newClient, iterations, maxFailures, soakPerIterationTimeout)
+	})
+}
+
+func testGrpc(runner *testRunner, clientConn *grpc.ClientConn, unresolvableClientConn *grpc.ClientConn, streamMessageDelay time.Duration) {
 	client := testgrpc.NewTestServiceClient(clientConn)
 	unresolvableClient := testgrpc.NewTestServiceClient(unresolvableClientConn)
-	for _, args := range [][]grpc.CallOption{
-		nil,
-		{grpc.UseCompressor(gzip.Name)},
-	} {
-		interopgrpc.DoEmptyUnaryCall(console.NewTB(), client, args...)
-		interopgrpc.DoLargeUnaryCall(console.NewTB(), client, args...)
-		interopgrpc.DoClientStreaming(console.NewTB(), client, args...)
-		interopgrpc.DoServerStreaming(console.NewTB(), client, args...)
-		interopgrpc.DoPingPong(console.NewTB(), client, args...)
-		interopgrpc.DoEmptyStream(console.NewTB(), client, args...)
-		interopgrpc.DoTimeoutOnSleepingServer(console.NewTB(), client, args...)
-		interopgrpc.DoCancelAfterBegin(console.NewTB(), client, args...)
-		interopgrpc.DoCancelAfterFirstResponse(console.NewTB(), client, args...)
-		interopgrpc.DoCustomMetadata(console.NewTB(), client, args...)
-		interopgrpc.DoStatusCodeAndMessage(console.NewTB(), client, args...)
-		interopgrpc.DoSpecialStatusMessage(console.NewTB(), client, args...)
-		interopgrpc.DoUnimplementedMethod(console.NewTB(), clientConn, args...)
-		interopgrpc.DoUnimplementedServerStreamingMethod(console.NewTB(), client, args...)
-		interopgrpc.DoFailWithNonASCIIError(console.NewTB(), client, args...)
-		interopgrpc.DoFailServerStreamingWithNonASCIIError(console.NewTB(), client, args...)
-	}
-	interopgrpc.DoUnimplementedService(console.NewTB(), testgrpc.NewUnimplementedServiceClient(clientConn))
-	interopgrpc.DoUnimplementedServerStreamingService(console.NewTB(), testgrpc.NewUnimplementedServiceClient(clientConn))
-	interopgrpc.DoUnresolvableHost(console.NewTB(), unresolvableClient)
+	testCases := []struct {
+		name   string
+		serial bool
+		run    func(crosstesting.TB, testgrpc.TestServiceClient, ...grpc.CallOption)
+	}{
+		{name: "empty_unary", run: interopgrpc.DoEmptyUnaryCall},
+		{name: "empty_unary_with_headers", run: interopgrpc.DoEmptyUnaryCallWithHeaders},
+		{name: "server_version", run: interopgrpc.DoServerVersion},
+		{name: "large_unary", run: interopgrpc.DoLargeUnaryCall},
+		{name: "large_unary_bidirectional_sizes", run: interopgrpc.DoLargeUnaryCallBidirectionalSizes},
+		{name: "cacheable_unary", run: interopgrpc.DoCacheableUnaryCall},
+		{name: "client_streaming", run: interopgrpc.DoClientStreaming},
+		{name: "streaming_input_call_large_aggregate", run: interopgrpc.DoStreamingInputCallLargeAggregate},
+		{name: "graceful_stream_half_close", run: interopgrpc.DoGracefulStreamHalfClose},
+		{name: "client_streaming_with_server_error", run: interopgrpc.DoClientStreamingWithServerError},
+		{name: "server_streaming", run: func(t crosstesting.TB, client testgrpc.TestServiceClient, args ...grpc.CallOption) {
+			interopgrpc.DoServerStreaming(t, client, streamMessageDelay, args...)
+		}},
+		{name: "server_streaming_large_message_count", run: interopgrpc.DoServerStreamingLargeMessageCount},
+		{name: "server_streaming_zero_interval_flood", run: interopgrpc.DoServerStreamingZeroIntervalFlood},
+		{name: "ping_pong", run: func(t crosstesting.TB, client testgrpc.TestServiceClient, args ...grpc.CallOption) {
+			interopgrpc.DoPingPong(t, client, streamMessageDelay, args...)
+		}},
+		{name: "interleaved_bidi_streaming", run: interopgrpc.DoInterleavedBidiStreaming},
+		{name: "many_concurrent_streams", run: interopgrpc.DoManyConcurrentStreams},
+		{name: "empty_stream", run: interopgrpc.DoEmptyStream},
+		{name: "empty_stream_client_streaming", run: interopgrpc.DoEmptyStreamClientStreaming},
+		{name: "streaming_input_call_empty_payload", run: interopgrpc.DoStreamingInputCallEmptyPayload},
+		{name: "empty_stream_server_streaming", run: interopgrpc.DoEmptyStreamServerStreaming},
+		{name: "timeout_on_sleeping_server", serial: true, run: interopgrpc.DoTimeoutOnSleepingServer},
+		{name: "deadline_propagation", serial: true, run: interopgrpc.DoDeadlinePropagation},
+		{name: "server_side_deadline_propagation", run: interopgrpc.DoUnaryWithServerSideContextDeadlinePropagation},
+		{name: "cancel_after_begin", run: interopgrpc.DoCancelAfterBegin},
+		{name: "cancel_after_begin_server_streaming", run: interopgrpc.DoCancelAfterBeginServerStreaming},
+		{name: "cancel_during_server_streaming", serial: true, run: interopgrpc.DoCancelDuringServerStreaming},
+		{name: "cancel_after_first_response_server_streaming", run: interopgrpc.DoCancelAfterFirstResponseServerStreaming},
+		{name: "cancel_after_first_response", run: interopgrpc.DoCancelAfterFirstResponse},
+		{name: "race_headers_and_body", run: interopgrpc.DoRaceHeadersAndBody},
+		{name: "custom_metadata", run: interopgrpc.DoCustomMetadata},
+		{name: "repeated_metadata_unary", run: interopgrpc.DoRepeatedMetadata},
+		{name: "unary_header_and_trailer_echo", run: interopgrpc.DoUnaryWithResponseHeaderAndTrailerEcho},
+		{name: "many_trailers", run: interopgrpc.DoManyTrailers},
+		{name: "many_long_trailers", run: interopgrpc.DoManyLongTrailers},
+		{name: "large_metadata_binary_trailer", run: interopgrpc.DoLargeMetadataBinaryTrailer},
+		{name: "repeated_metadata_full_duplex", run: interopgrpc.DoRepeatedMetadataFullDuplex},
+		{name: "metadata_in_trailer_only", run: interopgrpc.DoRequestResponseWithMetadataInTrailerOnly},
+		{name: "oversized_metadata", run: interopgrpc.DoOversizedMetadata},
+		{name: "orca_per_rpc", run: interopgrpc.DoOrcaPerRPC},
+		{name: "status_code_and_message", run: interopgrpc.DoStatusCodeAndMessage},
+		{name: "all_status_codes", run: interopgrpc.DoAllStatusCodes},
+		{name: "unary_with_invalid_argument", run: interopgrpc.DoUnaryWithInvalidArgument},
+		{name: "response_status_with_trailing_metadata", run: interopgrpc.DoResponseStatusWithTrailingMetadata},
+		{name: "special_status_message", run: interopgrpc.DoSpecialStatusMessage},
+		{name: "status_code_and_message_server_streaming", run: interopgrpc.DoStatusCodeAndMessageServerStreaming},
+		{name: "server_streaming_early_error", run: interopgrpc.DoServerStreamingEarlyError},
+		{name: "server_streaming_with_invalid_argument", run: interopgrpc.DoServerStreamingWithInvalidArgument},
+		{name: "full_duplex_with_invalid_argument", run: interopgrpc.DoFullDuplexWithInvalidArgument},
+		{name: "unimplemented_method", run: func(t crosstesting.TB, _ testgrpc.TestServiceClient, args ...grpc.CallOption) {
+			interopgrpc.DoUnimplementedMethod(t, clientConn, args...)
+		}},
+		{name: "unimplemented_server_streaming_method", run: interopgrpc.DoUnimplementedServerStreamingMethod},
+		{name: "fail_unary", run: interopgrpc.DoFailWithNonASCIIError},
+		{name: "error_with_details", run: interopgrpc.DoErrorWithDetails},
+		{name: "fail_server_streaming", run: interopgrpc.DoFailServerStreamingWithNonASCIIError},
+		{name: "fail_server_streaming_after_responses", run: interopgrpc.DoFailServerStreaming},
+	}
+	variants := []struct {
+		name string
+		args []grpc.CallOption
+	}{
+		{name: "uncompressed"},
+		{name: "compressed", args: []grpc.CallOption{grpc.UseCompressor(gzip.Name)}},
+	}
+	for _, variant := range variants {
+		for _, testCase := range testCases {
+			testCase, variant := testCase, variant
+			name := variantTestCaseName(testCase.name, variant.name)
+			run := func() { testCase.run(console.NewTB(name), client, variant.args...) }
+			if testCase.serial {
+				runner.runSerial(run)
+			} else {
+				runner.run(run)
+			}
+		}
+	}
+	// the compressed empty message test compresses its requests itself
+	runner.run(func() {
+		interopgrpc.DoUnaryWithCompressedEmptyMessage(console.NewTB("unary_with_compressed_empty_message"), client)
+	})
+	runner.run(func() {
+		interopgrpc.DoUnimplementedService(console.NewTB("unimplemented_service"), testgrpc.NewUnimplementedServiceClient(clientConn))
+	})
+	runner.run(func() {
+		interopgrpc.DoUnimplementedServerStreamingService(console.NewTB("unimplemented_server_streaming_service"), testgrpc.NewUnimplementedServiceClient(clientConn))
+	})
+	runner.run(func() { interopgrpc.DoUnresolvableHost(console.NewTB("unresolvable_host"), unresolvableClient) })
+	runner.run(func() { interopgrpc.DoHealthCheck(console.NewTB("health_check"), healthv1.NewHealthClient(clientConn)) })
+	runner.run(func() {
+		interopgrpc.DoServerReflection(console.NewTB("server_reflection"), reflectionpb.NewServerReflectionClient(clientConn))
+	})
 }
 
-func newTLSConfig(certFile, keyFile string) *tls.Config {
-	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+// newTransport creates a transport base on HTTP protocol of the implementation.
+// A nil tlsConfig creates an insecure transport, using h2c for HTTP/2. With a
+// non-nil tlsConfig, HTTP/1.1 transports only offer http/1.1 with ALPN. If the
+// dialer isn't nil, the transport dials the test server through it. If
+// strictStreams is set, HTTP/2 transports queue the streams over the server's
+// limit of concurrent streams instead of opening new connections for them.
+func newTransport(
+	implementation string,
+	tlsConfig *tls.Config,
+	dialer *unixSocketDialer,
+	ping keepaliveParams,
+	strictStreams bool,
+) http.RoundTripper {
+	switch implementation {
+	case connectH1, connectGRPCH1, connectGRPCWebH1, connectGRPCWebEnvoy:
+		transport := &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+		if tlsConfig != nil {
+			// offer http/1.1 explicitly, since no protocol is offered by default
+			// with a custom TLS config
+			transport.TLSClientConfig = tlsConfig.Clone()
+			transport.TLSClientConfig.NextProtos = []string{"http/1.1"}
+		}
+		if dialer != nil {
+			transport.DialContext = dialer.DialContext
+		}
+		return transport
+	case connectGRPCH2, connectH2, connectGRPCWebH2:
+		if tlsConfig == nil {
+			transport := clienttransport.NewH2C()
+			transport.ReadIdleTimeout = ping.interval
+			transport.PingTimeout = ping.timeout
+			transport.StrictMaxConcurrentStreams = strictStreams
+			if dialer != nil {
+				transport.DialTLS = func(network, addr string, _ *tls.Config) (net.Conn, error) {
+					return dialer.DialContext(context.Background(), network, addr)
+				}
+			}
+			return transport
+		}
+		transport := &http2.Transport{
+			TLSClientConfig: tlsConfig,
+			// ping the server when no frame was received for the keepalive interval
+			ReadIdleTimeout:            ping.interval,
+			PingTimeout:                ping.timeout,
+			StrictMaxConcurrentStreams: strictStreams,
+		}
+		if dialer != nil {
+			transport.DialTLS = dialer.DialTLS
+		}
+		return transport
+	case connectH3, connectGRPCWebH3:
+		// checkFlags rejects HTTP/3 without TLS or over a unix socket
+		return &http3.RoundTripper{
+			TLSClientConfig: tlsConfig,
+		}
+	default:
+		// the implementation is checked by checkFlags
+		return nil
+	}
+}
+
+// isLoopback reports whether the host is localhost or a loopback address.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ipv6LoopbackAvailable reports whether the IPv6 loopback address can be used,
+// which isn't the case in some containers.
+func ipv6LoopbackAvailable() bool {
+	listener, err := net.Listen("tcp", "[::1]:0")
 	if err != nil {
-		log.Fatalf("Error creating x509 keypair from client cert file %s and client key file %s", certFile, keyFile)
+		return false
 	}
-	caCert, err := ioutil.ReadFile("cert/CrosstestCA.crt")
+	_ = listener.Close()
+	return true
+}
+
+// unixSocketDialer dials a unix domain socket in place of the address of the
+// test server. Other addresses, like the unresolvable host, are dialed as usual.
+type unixSocketDialer struct {
+	serverAddr string
+	path       string
+}
+
+func (d *unixSocketDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if addr == d.serverAddr {
+		network, addr = "unix", d.path
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, addr)
+}
+
+// DialTLS implements http2.Transport.DialTLS, performing the TLS handshake the
+// transport would otherwise do itself.
+func (d *unixSocketDialer) DialTLS(network, addr string, config *tls.Config) (net.Conn, error) {
+	conn, err := d.DialContext(context.Background(), network, addr)
 	if err != nil {
-		log.Fatalf("Error opening cert file")
-	}
-	caCertPool := x509.NewCertPool()
-	caCertPool.AppendCertsFromPEM(caCert)
-	return &tls.Config{
-		MinVersion:   tls.VersionTLS12,
-		Certificates: []tls.Certificate{cert},
-		RootCAs:      caCertPool,
+		return nil, err
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// closeTransport closes the connections held by the transport.
+func closeTransport(transport http.RoundTripper) {
+	switch transport := transport.(type) {
+	case interface{ CloseIdleConnections() }:
+		transport.CloseIdleConnections()
+	case io.Closer:
+		_ = transport.Close()
 	}
 }
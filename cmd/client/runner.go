@@ -0,0 +1,61 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "sync"
+
+// testRunner runs test cases concurrently, up to its parallelism. With a
+// parallelism of 1, test cases run one at a time in the order they're given.
+type testRunner struct {
+	semaphore chan struct{}
+	waitGroup sync.WaitGroup
+}
+
+func newTestRunner(parallelism int) *testRunner {
+	return &testRunner{
+		semaphore: make(chan struct{}, parallelism),
+	}
+}
+
+// run runs the test case once a worker is available, concurrently with the
+// other test cases.
+func (r *testRunner) run(test func()) {
+	r.semaphore <- struct{}{}
+	r.waitGroup.Add(1)
+	go func() {
+		defer r.waitGroup.Done()
+		defer func() { <-r.semaphore }()
+		test()
+	}()
+}
+
+// runSerial runs the test case alone, once the running test cases are done.
+// It's meant for test cases that share mutable state, like the connections of
+// a transport, or that measure latencies or bound how long calls take. The test
+// case still runs in its own goroutine, which a failing test case stops.
+func (r *testRunner) runSerial(test func()) {
+	r.wait()
+	r.waitGroup.Add(1)
+	go func() {
+		defer r.waitGroup.Done()
+		test()
+	}()
+	r.wait()
+}
+
+// wait waits for the running test cases to be done.
+func (r *testRunner) wait() {
+	r.waitGroup.Wait()
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/tls"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/bufbuild/connect-crosstest/internal/clienttransport"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/http2"
+)
+
+const (
+	hostFlagName     = "host"
+	portFlagName     = "port"
+	insecureFlagName = "insecure"
+	caCertFlagName   = "cacert"
+	http1FlagName    = "http1"
+	jsonFlagName     = "json"
+)
+
+const (
+	protocolConnect = "connect"
+	protocolGRPC    = "grpc"
+	protocolGRPCWeb = "grpc-web"
+)
+
+type flags struct {
+	host       string
+	port       string
+	insecure   bool
+	caCertFile string
+	http1      bool
+	jsonFile   string
+}
+
+func main() {
+	flagset := &flags{}
+	rootCmd := &cobra.Command{
+		Use:   "crosstest",
+		Short: "Runs the test cases against a Connect test server with each of the Connect, gRPC and gRPC-Web protocols",
+		Run: func(cmd *cobra.Command, args []string) {
+			run(flagset)
+		},
+	}
+	if err := bind(rootCmd, flagset); err != nil {
+		os.Exit(1)
+	}
+	_ = rootCmd.Execute()
+}
+
+func bind(cmd *cobra.Command, flags *flags) error {
+	cmd.Flags().StringVar(&flags.host, hostFlagName, "127.0.0.1", "the host name of the test server")
+	cmd.Flags().StringVar(&flags.port, portFlagName, "", "the port of the test server")
+	cmd.Flags().BoolVar(&flags.insecure, insecureFlagName, false, "connect without TLS")
+	cmd.Flags().StringVar(&flags.caCertFile, caCertFlagName, "cert/CrosstestCA.crt", "path to the CA cert file used to verify the server")
+	cmd.Flags().BoolVar(&flags.http1, http1FlagName, false, "use HTTP/1.1 instead of HTTP/2, skipping the client and bidi streaming test cases")
+	cmd.Flags().StringVar(&flags.jsonFile, jsonFlagName, "", "path to write the results as JSON to, in addition to the grid")
+	for _, requiredFlag := range []string{portFlagName} {
+		if err := cmd.MarkFlagRequired(requiredFlag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func run(flags *flags) {
+	scheme := "https://"
+	if flags.insecure {
+		scheme = "http://"
+	}
+	serverURL := scheme + net.JoinHostPort(flags.host, flags.port)
+	httpClient := &http.Client{Transport: newTransport(flags)}
+	// the clients share the transport, so that the protocols only differ by
+	// their client options
+	clients := []protocolClient{
+		{
+			protocol: protocolConnect,
+			client:   testingconnect.NewTestServiceClient(httpClient, serverURL),
+		},
+		{
+			protocol: protocolGRPC,
+			client:   testingconnect.NewTestServiceClient(httpClient, serverURL, connect.WithGRPC()),
+		},
+		{
+			protocol: protocolGRPCWeb,
+			client:   testingconnect.NewTestServiceClient(httpClient, serverURL, connect.WithGRPCWeb()),
+		},
+	}
+	results := runMatrix(clients, flags.http1)
+	if err := writeGrid(os.Stdout, clients, results); err != nil {
+		log.Fatalf("failed to write the results: %v", err)
+	}
+	if flags.jsonFile != "" {
+		if err := writeJSON(flags.jsonFile, results); err != nil {
+			log.Fatalf("failed to write the results to %s: %v", flags.jsonFile, err)
+		}
+	}
+	for _, result := range results {
+		if result.Status == statusFail {
+			os.Exit(1)
+		}
+	}
+}
+
+// newTransport creates an HTTP/2 transport, or an HTTP/1.1 transport if the
+// --http1 flag is set.
+func newTransport(flags *flags) http.RoundTripper {
+	var tlsConfig *tls.Config
+	if !flags.insecure {
+		var err error
+		tlsConfig, err = clienttransport.NewTLSConfig(flags.caCertFile, "", "", tls.VersionTLS12)
+		if err != nil {
+			log.Fatalf("failed to create TLS config: %v", err)
+		}
+	}
+	if flags.http1 {
+		return &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	}
+	if flags.insecure {
+		return clienttransport.NewH2C()
+	}
+	return &http2.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+}
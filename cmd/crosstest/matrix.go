@@ -0,0 +1,214 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	"github.com/bufbuild/connect-crosstest/internal/interop/interopconnect"
+	"github.com/bufbuild/connect-go"
+)
+
+const (
+	statusPass = "pass"
+	statusFail = "fail"
+	statusSkip = "skip"
+)
+
+// protocolClient is a client calling the test server with a protocol.
+type protocolClient struct {
+	protocol string
+	client   testingconnect.TestServiceClient
+}
+
+// result is the result of a test case with a protocol, as written to the JSON
+// output.
+type result struct {
+	TestCase   string  `json:"test_case"`
+	Protocol   string  `json:"protocol"`
+	Status     string  `json:"status"`
+	DurationMS float64 `json:"duration_ms"`
+	Message    string  `json:"message,omitempty"`
+}
+
+// runMatrix runs each test case with each client, one at a time. The results
+// are ordered by test case, then by client.
+func runMatrix(clients []protocolClient, http1 bool) []result {
+	testCases := interopconnect.ClientTestCases(0)
+	results := make([]result, 0, len(testCases)*len(clients))
+	for _, testCase := range testCases {
+		for _, client := range clients {
+			// connect-go streams the request and the response at the same time for
+			// client and bidi streaming, which HTTP/1.1 doesn't support
+			if http1 && testCase.StreamType&connect.StreamTypeClient != 0 {
+				results = append(results, result{
+					TestCase: testCase.Name,
+					Protocol: client.protocol,
+					Status:   statusSkip,
+					Message:  "client and bidi streaming need HTTP/2",
+				})
+				continue
+			}
+			// gRPC sends trailers as HTTP trailers, which net/http limits to 4 KiB
+			// over HTTP/1.1
+			if http1 && testCase.LargeTrailers && client.protocol == protocolGRPC {
+				results = append(results, result{
+					TestCase: testCase.Name,
+					Protocol: client.protocol,
+					Status:   statusSkip,
+					Message:  "net/http limits HTTP/1.1 trailers to 4 KiB",
+				})
+				continue
+			}
+			results = append(results, runTestCase(testCase, client))
+		}
+	}
+	return results
+}
+
+// runTestCase runs the test case in its own goroutine, so that it can stop on
+// the first fatal failure without stopping the other test cases.
+func runTestCase(testCase interopconnect.ClientTestCase, client protocolClient) result {
+	tb := &recordingTB{}
+	start := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		testCase.Run(tb, client.client)
+	}()
+	<-done
+	status, message := tb.result()
+	return result{
+		TestCase:   testCase.Name,
+		Protocol:   client.protocol,
+		Status:     status,
+		DurationMS: float64(time.Since(start)) / float64(time.Millisecond),
+		Message:    message,
+	}
+}
+
+// writeGrid writes the results as a grid of test cases by protocol, followed by
+// the failure messages and the number of results by status.
+func writeGrid(writer io.Writer, clients []protocolClient, results []result) error {
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	header := []string{"TEST CASE"}
+	for _, client := range clients {
+		header = append(header, strings.ToUpper(client.protocol))
+	}
+	if _, err := fmt.Fprintln(tabWriter, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for row := 0; row < len(results); row += len(clients) {
+		cells := []string{results[row].TestCase}
+		for _, result := range results[row : row+len(clients)] {
+			cells = append(cells, result.Status)
+		}
+		if _, err := fmt.Fprintln(tabWriter, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+	if err := tabWriter.Flush(); err != nil {
+		return err
+	}
+	counts := make(map[string]int)
+	for _, result := range results {
+		counts[result.Status]++
+		if result.Status == statusFail {
+			if _, err := fmt.Fprintf(writer, "\nFAIL: %s with %s\n%s\n", result.TestCase, result.Protocol, result.Message); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(
+		writer,
+		"\n%d passed, %d failed, %d skipped\n",
+		counts[statusPass],
+		counts[statusFail],
+		counts[statusSkip],
+	)
+	return err
+}
+
+func writeJSON(path string, results []result) error {
+	bytes, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bytes, '\n'), 0600)
+}
+
+// recordingTB is a crosstesting.TB recording the failures of a test case. Like
+// console.TB, FailNow only stops the goroutine running the test case, as
+// testing.T does.
+type recordingTB struct {
+	mu        sync.Mutex
+	failed    bool
+	succeeded bool
+	messages  []string
+}
+
+func (t *recordingTB) Helper() {}
+
+func (t *recordingTB) Errorf(format string, args ...any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+	t.messages = append(t.messages, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingTB) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	t.FailNow()
+}
+
+func (t *recordingTB) Successf(string, ...any) {
+	t.mu.Lock()
+	failed := t.failed
+	t.succeeded = !failed
+	t.mu.Unlock()
+	if failed {
+		t.FailNow()
+	}
+}
+
+func (t *recordingTB) FailNow() {
+	t.mu.Lock()
+	t.failed = true
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+// result returns the status of the test case, and its failure messages. A test
+// case that returned without failing nor succeeding is reported as failed.
+func (t *recordingTB) result() (string, string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.succeeded && !t.failed {
+		return statusPass, ""
+	}
+	if len(t.messages) == 0 {
+		return statusFail, "test case returned without reporting success"
+	}
+	return statusFail, strings.Join(t.messages, "\n")
+}
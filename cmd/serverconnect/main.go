@@ -15,42 +15,70 @@
 package main
 
 import (
+	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/health/v1/healthv1connect"
 	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
 	serverpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/server/v1"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/bufbuild/connect-crosstest/internal/interop/interopconnect"
+	"github.com/bufbuild/connect-go"
 	"github.com/lucas-clemente/quic-go/http3"
 	"github.com/rs/cors"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/http2"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
-	h1PortFlagName = "h1port"
-	h2PortFlagName = "h2port"
-	h3PortFlagName = "h3port"
-	certFlagName   = "cert"
-	keyFlagName    = "key"
+	h1PortFlagName               = "h1port"
+	h2PortFlagName               = "h2port"
+	h3PortFlagName               = "h3port"
+	certFlagName                 = "cert"
+	keyFlagName                  = "key"
+	seedFlagName                 = "seed"
+	latencyFlagName              = "inject-latency"
+	logRPCsFlagName              = "log-rpcs"
+	authTokenFlagName            = "auth-token"
+	unixSocketFlagName           = "unix-socket"
+	maxConnectionAgeFlagName     = "max-connection-age"
+	maxConcurrentStreamsFlagName = "max-concurrent-streams"
+	gzipLevelFlagName            = "gzip-level"
+	tlsMinVersionFlagName        = "tls-min-version"
+	debugAddrFlagName            = "debug-addr"
 )
 
 type flags struct {
-	h1Port   string
-	h2Port   string
-	h3Port   string
-	certFile string
-	keyFile  string
+	h1Port               string
+	h2Port               string
+	h3Port               string
+	certFile             string
+	keyFile              string
+	seed                 int64
+	latency              time.Duration
+	logRPCs              bool
+	authToken            string
+	unixSocket           string
+	maxConnectionAge     time.Duration
+	maxConcurrentStreams uint32
+	gzipLevel            int
+	tlsMinVersion        string
+	debugAddr            string
 }
 
 func main() {
@@ -74,6 +102,31 @@ func bind(cmd *cobra.Command, flagset *flags) error {
 	cmd.Flags().StringVar(&flagset.h3Port, h3PortFlagName, "", "port for HTTP/3 traffic")
 	cmd.Flags().StringVar(&flagset.certFile, certFlagName, "", "path to the TLS cert file")
 	cmd.Flags().StringVar(&flagset.keyFile, keyFlagName, "", "path to the TLS key file")
+	cmd.Flags().Int64Var(&flagset.seed, seedFlagName, 0, "seed for the random payloads")
+	cmd.Flags().DurationVar(&flagset.latency, latencyFlagName, 0, "latency to add to the responses of unary test service calls")
+	cmd.Flags().BoolVar(&flagset.logRPCs, logRPCsFlagName, false, "log the procedure, peer, code and message sizes of each RPC")
+	cmd.Flags().StringVar(&flagset.authToken, authTokenFlagName, "", "bearer token required by the test service, if set")
+	cmd.Flags().StringVar(&flagset.unixSocket, unixSocketFlagName, "", "path of a unix domain socket for HTTP/1.1 and HTTP/2 traffic, in addition to the ports")
+	cmd.Flags().DurationVar(
+		&flagset.maxConnectionAge,
+		maxConnectionAgeFlagName,
+		0,
+		"age after which HTTP/1.1 and HTTP/2 connections are closed on their next response, with a GOAWAY for HTTP/2, if set",
+	)
+	cmd.Flags().Uint32Var(
+		&flagset.maxConcurrentStreams,
+		maxConcurrentStreamsFlagName,
+		0,
+		"maximum number of concurrent streams of each HTTP/2 connection, if set",
+	)
+	cmd.Flags().IntVar(&flagset.gzipLevel, gzipLevelFlagName, gzip.DefaultCompression, "level of the gzip compressed responses, from 1 for the best speed to 9 for the best compression")
+	cmd.Flags().StringVar(&flagset.tlsMinVersion, tlsMinVersionFlagName, "1.2", "minimum TLS version accepted by the server, 1.2 or 1.3")
+	cmd.Flags().StringVar(
+		&flagset.debugAddr,
+		debugAddrFlagName,
+		"",
+		"address of a plain HTTP server serving the RPC counts by method and code and the active streams as JSON on /debug/stats, if set",
+	)
 	for _, requiredFlag := range []string{h1PortFlagName, h2PortFlagName, certFlagName, keyFlagName} {
 		if err := cmd.MarkFlagRequired(requiredFlag); err != nil {
 			return err
@@ -83,10 +136,66 @@ func bind(cmd *cobra.Command, flagset *flags) error {
 }
 
 func run(flags *flags) {
+	tlsMinVersion, err := interop.ParseTLSVersion(flags.tlsMinVersion)
+	if err != nil {
+		log.Fatalf("invalid --%s flag: %v", tlsMinVersionFlagName, err)
+	}
+	// the options shared by all the services count the RPCs, log them and stamp
+	// the responses with the server version. The stats interceptor comes first,
+	// so that it counts the codes of the RPCs rejected by the other interceptors,
+	// and the logging interceptor comes next, so that the logged durations
+	// include the injected latency
+	var sharedOptions []connect.HandlerOption
+	stats := interopconnect.NewServerStats()
+	if flags.debugAddr != "" {
+		sharedOptions = append(sharedOptions, connect.WithInterceptors(interopconnect.NewStatsInterceptor(stats)))
+	}
+	if flags.logRPCs {
+		sharedOptions = append(sharedOptions, connect.WithInterceptors(interopconnect.NewRPCLoggingInterceptor()))
+	}
+	sharedOptions = append(sharedOptions, connect.WithInterceptors(interopconnect.NewVersionInterceptor(interop.Version)))
+	handlerOptions := append(
+		sharedOptions,
+		compression.WithZstd(),
+		compression.WithDeflate(),
+		compression.WithBrotli(),
+		connect.WithReadMaxBytes(interop.ServerReadMaxBytes),
+	)
+	if flags.gzipLevel != gzip.DefaultCompression {
+		gzipLevelOption, err := compression.WithGzipLevel(flags.gzipLevel)
+		if err != nil {
+			log.Fatalf("invalid --%s flag: %v", gzipLevelFlagName, err)
+		}
+		handlerOptions = append(handlerOptions, gzipLevelOption)
+	}
+	if flags.authToken != "" {
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(interopconnect.NewAuthInterceptor(flags.authToken)))
+	}
+	if flags.latency > 0 {
+		// simulate a slow backend for client deadlines and benchmarks
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(interopconnect.NewLatencyInterceptor(flags.latency)))
+	}
 	mux := http.NewServeMux()
 	mux.Handle(testingconnect.NewTestServiceHandler(
-		interopconnect.NewTestServiceHandler(),
+		interopconnect.NewTestServiceHandler(flags.seed),
+		handlerOptions...,
+	))
+	mux.Handle(healthv1connect.NewHealthHandler(
+		interopconnect.NewHealthHandler(testingconnect.TestServiceName),
+		sharedOptions...,
 	))
+	mux.Handle(interopconnect.NewServerReflectionHandler(
+		[]string{
+			testingconnect.TestServiceName,
+			healthv1connect.HealthName,
+		},
+		sharedOptions...,
+	))
+	// record the peer of each request for the RPC logs and the peer address test
+	handler := interopconnect.NewPeerHandler(mux)
+	if flags.maxConnectionAge > 0 {
+		handler = interopconnect.NewMaxConnectionAgeHandler(handler, flags.maxConnectionAge)
+	}
 	corsHandler := cors.New(cors.Options{
 		AllowedMethods: []string{
 			http.MethodHead,
@@ -116,25 +225,49 @@ func run(flags *flags) {
 		ExposedHeaders: []string{
 			"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "X-Grpc-Test-Echo-Initial",
 			"Trailer-X-Grpc-Test-Echo-Trailing-Bin"},
-	}).Handler(mux)
-	tlsConfig := newTLSConfig(flags.certFile, flags.keyFile)
+	}).Handler(handler)
+	tlsConfig := newTLSConfig(flags.certFile, flags.keyFile, tlsMinVersion)
 	h1Server := http.Server{
-		Addr:    ":" + flags.h1Port,
-		Handler: corsHandler,
+		Addr:        ":" + flags.h1Port,
+		Handler:     corsHandler,
+		TLSConfig:   tlsConfig,
+		ConnContext: interopconnect.ConnStartContext,
 	}
 	h2Server := http.Server{
-		Addr:      ":" + flags.h2Port,
-		Handler:   mux,
-		TLSConfig: tlsConfig,
+		Addr:        ":" + flags.h2Port,
+		Handler:     handler,
+		TLSConfig:   tlsConfig,
+		ConnContext: interopconnect.ConnStartContext,
 	}
 	var h3Server http3.Server
 	if flags.h3Port != "" {
 		h3Server = http3.Server{
 			Addr:      ":" + flags.h3Port,
-			Handler:   mux,
+			Handler:   handler,
 			TLSConfig: tlsConfig,
 		}
 	}
+	unixServer := http.Server{
+		Handler:     handler,
+		TLSConfig:   tlsConfig,
+		ConnContext: interopconnect.ConnStartContext,
+	}
+	var debugServer http.Server
+	if flags.debugAddr != "" {
+		debugMux := http.NewServeMux()
+		debugMux.Handle("/debug/stats", interopconnect.NewStatsHandler(stats))
+		debugServer = http.Server{
+			Addr:    flags.debugAddr,
+			Handler: debugMux,
+		}
+	}
+	if flags.maxConcurrentStreams > 0 {
+		for _, server := range []*http.Server{&h2Server, &unixServer} {
+			if err := http2.ConfigureServer(server, &http2.Server{MaxConcurrentStreams: flags.maxConcurrentStreams}); err != nil {
+				log.Fatalf("failed to configure HTTP/2: %v", err)
+			}
+		}
+	}
 	protocols := []*serverpb.ProtocolSupport{
 		{
 			Protocol: serverpb.Protocol_PROTOCOL_GRPC_WEB,
@@ -213,6 +346,28 @@ func run(flags *flags) {
 			}
 		}()
 	}
+	if flags.unixSocket != "" {
+		// remove the socket left by a previous run, if any
+		if err := os.Remove(flags.unixSocket); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Fatalln(err)
+		}
+		unixListener, err := net.Listen("unix", flags.unixSocket)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		go func() {
+			if err := unixServer.ServeTLS(unixListener, flags.certFile, flags.keyFile); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalln(err)
+			}
+		}()
+	}
+	if flags.debugAddr != "" {
+		go func() {
+			if err := debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalln(err)
+			}
+		}()
+	}
 	<-done
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -222,14 +377,24 @@ func run(flags *flags) {
 	if err := h2Server.Shutdown(ctx); err != nil {
 		log.Fatalln(err)
 	}
+	if flags.unixSocket != "" {
+		if err := unixServer.Shutdown(ctx); err != nil {
+			log.Fatalln(err)
+		}
+	}
 	if flags.h3Port != "" {
 		if err := h3Server.Close(); err != nil {
 			log.Fatalln(err)
 		}
 	}
+	if flags.debugAddr != "" {
+		if err := debugServer.Shutdown(ctx); err != nil {
+			log.Fatalln(err)
+		}
+	}
 }
 
-func newTLSConfig(certFile, keyFile string) *tls.Config {
+func newTLSConfig(certFile, keyFile string, minVersion uint16) *tls.Config {
 	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
 	if err != nil {
 		log.Fatalf("Error creating x509 keypair from client cert file %s and client key file %s", certFile, keyFile)
@@ -241,7 +406,7 @@ func newTLSConfig(certFile, keyFile string) *tls.Config {
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM(caCert)
 	return &tls.Config{
-		MinVersion:   tls.VersionTLS12,
+		MinVersion:   minVersion,
 		Certificates: []tls.Certificate{cert},
 		RootCAs:      caCertPool,
 	}
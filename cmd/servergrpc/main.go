@@ -15,34 +15,60 @@
 package main
 
 import (
+	compressgzip "compress/gzip"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+	healthv1 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/health/v1"
 	testrpc "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
 	serverpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/server/v1"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/bufbuild/connect-crosstest/internal/interop/interopgrpc"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	_ "google.golang.org/grpc/encoding/gzip" // this register the gzip compressor to the grpc server
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip" // this register the gzip compressor to the grpc server
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
-	portFlagName = "port"
-	certFlagName = "cert"
-	keyFlagName  = "key"
+	portFlagName                 = "port"
+	certFlagName                 = "cert"
+	keyFlagName                  = "key"
+	seedFlagName                 = "seed"
+	authTokenFlagName            = "auth-token"
+	unixSocketFlagName           = "unix-socket"
+	maxConnectionAgeFlagName     = "max-connection-age"
+	maxConcurrentStreamsFlagName = "max-concurrent-streams"
+	gzipLevelFlagName            = "gzip-level"
+	tlsMinVersionFlagName        = "tls-min-version"
 )
 
 type flags struct {
-	port     string
-	certFile string
-	keyFile  string
+	port                 string
+	certFile             string
+	keyFile              string
+	seed                 int64
+	authToken            string
+	unixSocket           string
+	maxConnectionAge     time.Duration
+	maxConcurrentStreams uint32
+	gzipLevel            int
+	tlsMinVersion        string
 }
 
 func main() {
@@ -64,6 +90,13 @@ func bind(cmd *cobra.Command, flagset *flags) error {
 	cmd.Flags().StringVar(&flagset.port, portFlagName, "", "the port the server will listen on")
 	cmd.Flags().StringVar(&flagset.certFile, certFlagName, "", "path to the TLS cert file")
 	cmd.Flags().StringVar(&flagset.keyFile, keyFlagName, "", "path to the TLS key file")
+	cmd.Flags().Int64Var(&flagset.seed, seedFlagName, 0, "seed for the random payloads")
+	cmd.Flags().StringVar(&flagset.authToken, authTokenFlagName, "", "bearer token required by the test service, if set")
+	cmd.Flags().StringVar(&flagset.unixSocket, unixSocketFlagName, "", "path of a unix domain socket the server will also listen on")
+	cmd.Flags().DurationVar(&flagset.maxConnectionAge, maxConnectionAgeFlagName, 0, "age after which connections are closed with a GOAWAY, if set")
+	cmd.Flags().Uint32Var(&flagset.maxConcurrentStreams, maxConcurrentStreamsFlagName, 0, "maximum number of concurrent streams of each connection, if set")
+	cmd.Flags().IntVar(&flagset.gzipLevel, gzipLevelFlagName, compressgzip.DefaultCompression, "level of the gzip compressed responses, from 1 for the best speed to 9 for the best compression")
+	cmd.Flags().StringVar(&flagset.tlsMinVersion, tlsMinVersionFlagName, "1.2", "minimum TLS version accepted by the server, 1.2 or 1.3")
 	for _, requiredFlag := range []string{portFlagName, certFlagName, keyFlagName} {
 		if err := cmd.MarkFlagRequired(requiredFlag); err != nil {
 			return err
@@ -73,13 +106,49 @@ func bind(cmd *cobra.Command, flagset *flags) error {
 }
 
 func run(flagset *flags) {
+	if flagset.gzipLevel != compressgzip.DefaultCompression {
+		if err := gzip.SetLevel(flagset.gzipLevel); err != nil {
+			log.Fatalf("invalid --%s flag: %v", gzipLevelFlagName, err)
+		}
+	}
+	tlsMinVersion, err := interop.ParseTLSVersion(flagset.tlsMinVersion)
+	if err != nil {
+		log.Fatalf("invalid --%s flag: %v", tlsMinVersionFlagName, err)
+	}
+	encoding.RegisterCompressor(compression.NewZstdGRPCCompressor())
+	encoding.RegisterCompressor(compression.NewDeflateGRPCCompressor())
+	encoding.RegisterCompressor(compression.NewBrotliGRPCCompressor())
 	lis, err := net.Listen("tcp", ":"+flagset.port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	server := grpc.NewServer(
-		grpc.Creds(credentials.NewTLS(newTLSConfig(flagset.certFile, flagset.keyFile))),
+	serverOptions := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(newTLSConfig(flagset.certFile, flagset.keyFile, tlsMinVersion))),
+		grpc.MaxRecvMsgSize(interop.ServerReadMaxBytes),
+	}
+	versionUnaryInterceptor, versionStreamInterceptor := interopgrpc.NewVersionInterceptors(interop.Version)
+	serverOptions = append(
+		serverOptions,
+		grpc.ChainUnaryInterceptor(versionUnaryInterceptor),
+		grpc.ChainStreamInterceptor(versionStreamInterceptor),
 	)
+	if flagset.authToken != "" {
+		unaryInterceptor, streamInterceptor := interopgrpc.NewAuthInterceptors(flagset.authToken)
+		serverOptions = append(
+			serverOptions,
+			grpc.ChainUnaryInterceptor(unaryInterceptor),
+			grpc.ChainStreamInterceptor(streamInterceptor),
+		)
+	}
+	if flagset.maxConnectionAge > 0 {
+		serverOptions = append(serverOptions, grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionAge: flagset.maxConnectionAge,
+		}))
+	}
+	if flagset.maxConcurrentStreams > 0 {
+		serverOptions = append(serverOptions, grpc.MaxConcurrentStreams(flagset.maxConcurrentStreams))
+	}
+	server := grpc.NewServer(serverOptions...)
 	bytes, err := protojson.Marshal(
 		&serverpb.ServerMetadata{
 			Host: "localhost",
@@ -100,12 +169,37 @@ func run(flagset *flags) {
 		log.Fatalf("failed to marshal server metadata: %v", err)
 	}
 	_, _ = fmt.Fprintln(os.Stdout, string(bytes))
-	testrpc.RegisterTestServiceServer(server, interopgrpc.NewTestServer())
-	_ = server.Serve(lis)
-	defer server.GracefulStop()
+	testrpc.RegisterTestServiceServer(server, interopgrpc.NewTestServer(flagset.seed))
+	healthv1.RegisterHealthServer(server, interopgrpc.NewHealthServer(testrpc.TestService_ServiceDesc.ServiceName))
+	reflection.Register(server)
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Fatalln(err)
+		}
+	}()
+	if flagset.unixSocket != "" {
+		// remove the socket left by a previous run, if any
+		if err := os.Remove(flagset.unixSocket); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Fatalln(err)
+		}
+		unixListener, err := net.Listen("unix", flagset.unixSocket)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		go func() {
+			if err := server.Serve(unixListener); err != nil {
+				log.Fatalln(err)
+			}
+		}()
+	}
+	<-done
+	// stop accepting new connections, and wait for the in-flight RPCs to complete
+	server.GracefulStop()
 }
 
-func newTLSConfig(certFile, keyFile string) *tls.Config {
+func newTLSConfig(certFile, keyFile string, minVersion uint16) *tls.Config {
 	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
 	if err != nil {
 		log.Fatalf("Error creating x509 keypair from client cert file %s and client key file %s", certFile, keyFile)
@@ -117,7 +211,7 @@ func newTLSConfig(certFile, keyFile string) *tls.Config {
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM(caCert)
 	return &tls.Config{
-		MinVersion:   tls.VersionTLS12,
+		MinVersion:   minVersion,
 		Certificates: []tls.Certificate{cert},
 		RootCAs:      caCertPool,
 	}
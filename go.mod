@@ -3,12 +3,15 @@ module github.com/bufbuild/connect-crosstest
 go 1.18
 
 require (
+	github.com/andybalholm/brotli v1.0.4
 	github.com/bufbuild/connect-go v0.2.0
+	github.com/klauspost/compress v1.15.9
 	github.com/lucas-clemente/quic-go v0.28.0
 	github.com/rs/cors v1.8.2
 	github.com/spf13/cobra v1.5.0
 	github.com/stretchr/testify v1.8.0
 	golang.org/x/net v0.0.0-20220624214902-1bab6f366d9e
+	google.golang.org/genproto v0.0.0-20220602131408-e326c6e8e9c8
 	google.golang.org/grpc v1.49.0-dev
 	google.golang.org/protobuf v1.28.0
 )
@@ -35,7 +38,6 @@ require (
 	golang.org/x/text v0.3.7 // indirect
 	golang.org/x/tools v0.1.10 // indirect
 	golang.org/x/xerrors v0.0.0-20220517211312-f3a8303e98df // indirect
-	google.golang.org/genproto v0.0.0-20220602131408-e326c6e8e9c8 // indirect
 	gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
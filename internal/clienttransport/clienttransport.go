@@ -0,0 +1,62 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clienttransport implements the TLS configs and HTTP transports
+// shared by the commands calling the test servers.
+package clienttransport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"golang.org/x/net/http2"
+)
+
+// NewTLSConfig creates a TLS config verifying the server with the CA cert, and
+// presenting the client cert for mutual TLS if one is provided.
+func NewTLSConfig(caCertFile, certFile, keyFile string, minVersion uint16) (*tls.Config, error) {
+	caCert, err := ioutil.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("read CA cert file: %w", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("parse CA cert file %s", caCertFile)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: minVersion,
+		RootCAs:    caCertPool,
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("create x509 keypair from client cert file %s and client key file %s: %w", certFile, keyFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// NewH2C returns an HTTP/2 transport that connects without TLS.
+func NewH2C() *http2.Transport {
+	return &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// WithAccept returns a ClientOption that makes the named compression
+// algorithms available to a connect client, in order of preference. Connect
+// clients always accept gzip, so it's the least preferred algorithm unless it's
+// named.
+//
+// Algorithms other than gzip, zstd, deflate and brotli are advertised to
+// servers, but fail to decompress. Since servers must ignore the algorithms
+// they don't support, this tests that they don't pick them.
+func WithAccept(names ...string) connect.ClientOption {
+	options := make([]connect.ClientOption, 0, len(names))
+	// connect clients prefer the last registered algorithm
+	for i := len(names) - 1; i >= 0; i-- {
+		switch name := names[i]; name {
+		case Gzip:
+			options = append(options, connect.WithAcceptCompression(Gzip, newGzipDecompressor, newGzipCompressor))
+		case Zstd:
+			options = append(options, WithAcceptZstd())
+		case Deflate:
+			options = append(options, WithAcceptDeflate())
+		case Brotli:
+			options = append(options, WithAcceptBrotli())
+		default:
+			options = append(options, connect.WithAcceptCompression(
+				name,
+				func() connect.Decompressor { return &unsupportedDecompressor{name: name} },
+				func() connect.Compressor { return &unsupportedCompressor{name: name} },
+			))
+		}
+	}
+	return connect.WithClientOptions(options...)
+}
+
+type unsupportedDecompressor struct {
+	name string
+}
+
+func (d *unsupportedDecompressor) Read([]byte) (int, error) {
+	return 0, errUnsupported(d.name)
+}
+
+func (d *unsupportedDecompressor) Reset(io.Reader) error {
+	return errUnsupported(d.name)
+}
+
+func (d *unsupportedDecompressor) Close() error {
+	return nil
+}
+
+type unsupportedCompressor struct {
+	name string
+}
+
+func (c *unsupportedCompressor) Write([]byte) (int, error) {
+	return 0, errUnsupported(c.name)
+}
+
+func (c *unsupportedCompressor) Reset(io.Writer) {}
+
+func (c *unsupportedCompressor) Close() error {
+	return nil
+}
+
+func errUnsupported(name string) error {
+	return fmt.Errorf("compression %q is advertised but not supported", name)
+}
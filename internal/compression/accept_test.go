@@ -0,0 +1,76 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-crosstest/internal/interop/interopconnect"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAcceptOnlyUnsupported(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(
+		interopconnect.NewTestServiceHandler(0),
+		compression.WithZstd(),
+		compression.WithDeflate(),
+		compression.WithBrotli(),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	unsupported := []string{"snappy", "lz4"}
+	// connect clients always accept gzip, so the transport drops it from the
+	// algorithms advertised by the client
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			accepted := strings.Split(request.Header.Get("Accept-Encoding"), ",")
+			var withoutGzip []string
+			for _, name := range accepted {
+				if name = strings.TrimSpace(name); name != compression.Gzip {
+					withoutGzip = append(withoutGzip, name)
+				}
+			}
+			assert.ElementsMatch(t, unsupported, withoutGzip)
+			request.Header.Set("Accept-Encoding", strings.Join(withoutGzip, ", "))
+			return server.Client().Transport.RoundTrip(request)
+		}),
+	}
+	client := testingconnect.NewTestServiceClient(httpClient, server.URL, compression.WithAccept(unsupported...))
+	const size = 1024
+	response, err := client.UnaryCall(context.Background(), connect.NewRequest(&testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: size,
+	}))
+	require.NoError(t, err)
+	assert.Empty(t, response.Header().Get("Content-Encoding"))
+	assert.Len(t, response.Msg.GetPayload().GetBody(), size)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"io"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/grpc/encoding"
+)
+
+// Brotli is the name of the brotli compression algorithm.
+const Brotli = "br"
+
+// WithBrotli returns a HandlerOption that registers brotli compression with a
+// connect handler.
+func WithBrotli() connect.HandlerOption {
+	return connect.WithCompression(Brotli, newBrotliDecompressor, newBrotliCompressor)
+}
+
+// WithAcceptBrotli returns a ClientOption that registers brotli compression
+// with a connect client.
+func WithAcceptBrotli() connect.ClientOption {
+	return connect.WithAcceptCompression(Brotli, newBrotliDecompressor, newBrotliCompressor)
+}
+
+// NewBrotliGRPCCompressor returns a brotli compressor for grpc-go, suitable for
+// encoding.RegisterCompressor. Unlike connect, grpc-go doesn't pool
+// compressors, so the compressor pools its writers and readers itself, reusing
+// them once closed.
+func NewBrotliGRPCCompressor() encoding.Compressor {
+	return &brotliGRPCCompressor{}
+}
+
+type brotliDecompressor struct {
+	reader *brotli.Reader
+}
+
+func newBrotliDecompressor() connect.Decompressor {
+	return &brotliDecompressor{reader: brotli.NewReader(nil)}
+}
+
+func (d *brotliDecompressor) Read(bytes []byte) (int, error) {
+	return d.reader.Read(bytes)
+}
+
+func (d *brotliDecompressor) Reset(reader io.Reader) error {
+	return d.reader.Reset(reader)
+}
+
+// Close is a no-op: a brotli.Reader holds no resources beyond its buffers,
+// which are reused when connect resets the pooled decompressor.
+func (d *brotliDecompressor) Close() error {
+	return nil
+}
+
+func newBrotliCompressor() connect.Compressor {
+	return brotli.NewWriter(nil)
+}
+
+type brotliGRPCCompressor struct {
+	writers sync.Pool
+	readers sync.Pool
+}
+
+func (c *brotliGRPCCompressor) Compress(writer io.Writer) (io.WriteCloser, error) {
+	pooled, ok := c.writers.Get().(*pooledBrotliWriter)
+	if !ok {
+		pooled = &pooledBrotliWriter{Writer: brotli.NewWriter(nil), pool: &c.writers}
+	}
+	pooled.Reset(writer)
+	return pooled, nil
+}
+
+func (c *brotliGRPCCompressor) Decompress(reader io.Reader) (io.Reader, error) {
+	pooled, ok := c.readers.Get().(*pooledBrotliReader)
+	if !ok {
+		pooled = &pooledBrotliReader{Reader: brotli.NewReader(nil), pool: &c.readers}
+	}
+	if err := pooled.Reset(reader); err != nil {
+		c.readers.Put(pooled)
+		return nil, err
+	}
+	return pooled, nil
+}
+
+func (c *brotliGRPCCompressor) Name() string {
+	return Brotli
+}
+
+// pooledBrotliWriter returns itself to the pool once closed.
+type pooledBrotliWriter struct {
+	*brotli.Writer
+
+	pool *sync.Pool
+}
+
+func (w *pooledBrotliWriter) Close() error {
+	defer w.pool.Put(w)
+	return w.Writer.Close()
+}
+
+// pooledBrotliReader returns itself to the pool once closed. grpc-go doesn't
+// close the readers it decompresses with, so they aren't reused with grpc-go,
+// but returning them at the end of the compressed data instead would hand out
+// readers that may still be read from.
+type pooledBrotliReader struct {
+	*brotli.Reader
+
+	pool *sync.Pool
+}
+
+func (r *pooledBrotliReader) Close() error {
+	r.pool.Put(r)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"compress/zlib"
+	"io"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/grpc/encoding"
+)
+
+// Deflate is the name of the deflate compression algorithm. As in HTTP's
+// content-encoding, deflate is the zlib format wrapping a compress/flate
+// stream.
+const Deflate = "deflate"
+
+// WithDeflate returns a HandlerOption that registers deflate compression with
+// a connect handler.
+func WithDeflate() connect.HandlerOption {
+	return connect.WithCompression(Deflate, newDeflateDecompressor, newDeflateCompressor)
+}
+
+// WithAcceptDeflate returns a ClientOption that registers deflate compression
+// with a connect client.
+func WithAcceptDeflate() connect.ClientOption {
+	return connect.WithAcceptCompression(Deflate, newDeflateDecompressor, newDeflateCompressor)
+}
+
+// NewDeflateGRPCCompressor returns a deflate compressor for grpc-go, suitable
+// for encoding.RegisterCompressor.
+func NewDeflateGRPCCompressor() encoding.Compressor {
+	return &deflateGRPCCompressor{}
+}
+
+type deflateDecompressor struct {
+	reader io.ReadCloser
+}
+
+func newDeflateDecompressor() connect.Decompressor {
+	// zlib.NewReader reads the zlib header right away, so the reader is only
+	// created once the decompressor is reset with the compressed data.
+	return &deflateDecompressor{}
+}
+
+func (d *deflateDecompressor) Read(bytes []byte) (int, error) {
+	if d.reader == nil {
+		return 0, io.EOF
+	}
+	return d.reader.Read(bytes)
+}
+
+func (d *deflateDecompressor) Reset(reader io.Reader) error {
+	if resetter, ok := d.reader.(zlib.Resetter); ok {
+		return resetter.Reset(reader, nil)
+	}
+	zlibReader, err := zlib.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	d.reader = zlibReader
+	return nil
+}
+
+func (d *deflateDecompressor) Close() error {
+	if d.reader == nil {
+		return nil
+	}
+	return d.reader.Close()
+}
+
+func newDeflateCompressor() connect.Compressor {
+	return zlib.NewWriter(nil)
+}
+
+type deflateGRPCCompressor struct{}
+
+func (c *deflateGRPCCompressor) Compress(writer io.Writer) (io.WriteCloser, error) {
+	return zlib.NewWriter(writer), nil
+}
+
+func (c *deflateGRPCCompressor) Decompress(reader io.Reader) (io.Reader, error) {
+	return zlib.NewReader(reader)
+}
+
+func (c *deflateGRPCCompressor) Name() string {
+	return Deflate
+}
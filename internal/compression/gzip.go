@@ -0,0 +1,77 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"compress/gzip"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// Gzip is the name of the gzip compression algorithm.
+const Gzip = "gzip"
+
+// CheckGzipLevel returns an error if the level isn't one of the levels of
+// compress/gzip, from gzip.HuffmanOnly to gzip.BestCompression.
+func CheckGzipLevel(level int) error {
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return fmt.Errorf("invalid gzip level %d, must be between %d and %d", level, gzip.HuffmanOnly, gzip.BestCompression)
+	}
+	return nil
+}
+
+// WithGzipLevel returns a HandlerOption that registers gzip compression at the
+// given level with a connect handler, replacing connect's own gzip compressor,
+// which always uses the default level. It fails if the level is invalid.
+func WithGzipLevel(level int) (connect.HandlerOption, error) {
+	newCompressor, err := newGzipLevelCompressor(level)
+	if err != nil {
+		return nil, err
+	}
+	return connect.WithCompression(Gzip, newGzipDecompressor, newCompressor), nil
+}
+
+// WithAcceptGzipLevel returns a ClientOption that registers gzip compression
+// at the given level with a connect client, replacing connect's own gzip
+// compressor. Registering gzip again replaces the previous registration, so it
+// must only be used once by each client. It fails if the level is invalid.
+func WithAcceptGzipLevel(level int) (connect.ClientOption, error) {
+	newCompressor, err := newGzipLevelCompressor(level)
+	if err != nil {
+		return nil, err
+	}
+	return connect.WithAcceptCompression(Gzip, newGzipDecompressor, newCompressor), nil
+}
+
+func newGzipDecompressor() connect.Decompressor {
+	// Reset initializes the zero value with the compressed data.
+	return &gzip.Reader{}
+}
+
+func newGzipCompressor() connect.Compressor {
+	return gzip.NewWriter(nil)
+}
+
+func newGzipLevelCompressor(level int) (func() connect.Compressor, error) {
+	if err := CheckGzipLevel(level); err != nil {
+		return nil, err
+	}
+	return func() connect.Compressor {
+		// NewWriterLevel only fails for the invalid levels checked above
+		writer, _ := gzip.NewWriterLevel(nil, level)
+		return writer
+	}, nil
+}
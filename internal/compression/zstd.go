@@ -0,0 +1,95 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compression implements compression algorithms beyond the gzip
+// support built into connect-go and grpc-go.
+package compression
+
+import (
+	"io"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+// Zstd is the name of the zstd compression algorithm.
+const Zstd = "zstd"
+
+// WithZstd returns a HandlerOption that registers zstd compression with a
+// connect handler.
+func WithZstd() connect.HandlerOption {
+	return connect.WithCompression(Zstd, newZstdDecompressor, newZstdCompressor)
+}
+
+// WithAcceptZstd returns a ClientOption that registers zstd compression with a
+// connect client.
+func WithAcceptZstd() connect.ClientOption {
+	return connect.WithAcceptCompression(Zstd, newZstdDecompressor, newZstdCompressor)
+}
+
+// NewZstdGRPCCompressor returns a zstd compressor for grpc-go, suitable for
+// encoding.RegisterCompressor.
+func NewZstdGRPCCompressor() encoding.Compressor {
+	return &zstdGRPCCompressor{}
+}
+
+type zstdDecompressor struct {
+	decoder *zstd.Decoder
+}
+
+func newZstdDecompressor() connect.Decompressor {
+	// NewReader only fails on invalid options. A concurrency of one keeps
+	// decoding synchronous, so no goroutines need to be released on Close.
+	decoder, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	return &zstdDecompressor{decoder: decoder}
+}
+
+func (d *zstdDecompressor) Read(bytes []byte) (int, error) {
+	return d.decoder.Read(bytes)
+}
+
+func (d *zstdDecompressor) Reset(reader io.Reader) error {
+	return d.decoder.Reset(reader)
+}
+
+// Close is a no-op: connect pools decompressors and resets them after Close,
+// but a closed zstd.Decoder can't be reset.
+func (d *zstdDecompressor) Close() error {
+	return nil
+}
+
+func newZstdCompressor() connect.Compressor {
+	// NewWriter only fails on invalid options.
+	encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	return encoder
+}
+
+type zstdGRPCCompressor struct{}
+
+func (c *zstdGRPCCompressor) Compress(writer io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(writer, zstd.WithEncoderConcurrency(1))
+}
+
+func (c *zstdGRPCCompressor) Decompress(reader io.Reader) (io.Reader, error) {
+	decoder, err := zstd.NewReader(reader, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return decoder, nil
+}
+
+func (c *zstdGRPCCompressor) Name() string {
+	return Zstd
+}
@@ -16,18 +16,73 @@
 package console
 
 import (
+	"fmt"
 	"log"
-	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
-// TB is a tb.
+// The TBs are created independently by each test case, so verbosity is set
+// for the process.
+var verbose bool // nolint:gochecknoglobals
+
+// SetVerbose sets whether the duration of each test case is logged.
+func SetVerbose(enabled bool) {
+	verbose = enabled
+}
+
+// The suite is run by the process as a whole, so its iteration is also set for
+// the process.
+var iteration int // nolint:gochecknoglobals
+
+// SetIteration sets the iteration of the suite the next test cases run in,
+// starting at 1, so that failures and results report it. It must be called
+// between iterations, when no test case is running. The default of zero means
+// the suite runs once, and isn't reported.
+func SetIteration(i int) {
+	iteration = i
+}
+
+// The test cases fail independently, while the process checks whether each
+// iteration of the suite failed, so failures are counted for the process.
+var failures atomic.Int64 // nolint:gochecknoglobals
+
+// Failures returns the number of test cases that failed so far, so that the
+// failures of each iteration of the suite can be told apart.
+func Failures() int64 {
+	return failures.Load()
+}
+
+// logPrefix returns the prefix of the log lines of the test cases, with the
+// iteration of the suite if set.
+func logPrefix(status string) string {
+	if iteration > 0 {
+		return fmt.Sprintf("%s iteration %d: ", status, iteration)
+	}
+	return status + " "
+}
+
+// TB is a tb. It is safe for concurrent use, so that a test case can report
+// failures from multiple goroutines.
 type TB struct {
-	failed bool
+	name     string
+	mu       sync.Mutex
+	failed   bool
+	start    time.Time
+	messages []string
 }
 
-// NewTB returns a new TB.
-func NewTB() *TB {
-	return &TB{}
+// NewTB returns a new TB for the test case with the given name, which names its
+// result in the report. A TB is meant to be created right before running a
+// test case, since the duration of the test case is measured from then.
+func NewTB(name string) *TB {
+	return &TB{
+		name:  name,
+		start: time.Now(),
+	}
 }
 
 // Helper implements TB.Helper.
@@ -37,24 +92,56 @@ func (t *TB) Helper() {}
 func (t *TB) Errorf(format string, args ...any) {
 	// t.Errorf was called at least once, so a failed test case
 	// was found.
+	t.mu.Lock()
 	t.failed = true
-	log.Printf("ERROR: "+format, args...)
+	t.messages = append(t.messages, fmt.Sprintf(format, args...))
+	t.mu.Unlock()
+	log.Printf(logPrefix("ERROR:")+format, args...)
 }
 
 // Fatalf implements TB.Fatalf.
 func (t *TB) Fatalf(format string, args ...any) {
-	log.Printf("FATAL: "+format, args...)
+	t.mu.Lock()
+	t.messages = append(t.messages, fmt.Sprintf(format, args...))
+	t.mu.Unlock()
+	log.Printf(logPrefix("FATAL:")+format, args...)
 }
 
 // Successf implements TB.Successf.
 func (t *TB) Successf(format string, args ...any) {
-	if t.failed {
+	t.mu.Lock()
+	failed := t.failed
+	t.mu.Unlock()
+	if failed {
 		t.FailNow()
 	}
-	log.Printf("PASS:  "+format, args...)
+	t.finish(statusPass, "")
+	log.Printf(logPrefix("PASS: ")+format, args...)
 }
 
-// FailNow implements TB.FailNow.
+// FailNow implements TB.FailNow. It records the failure and stops the
+// goroutine running the test case, as testing.T does, so that the other test
+// cases keep running.
 func (t *TB) FailNow() {
-	os.Exit(1)
+	t.mu.Lock()
+	message := strings.Join(t.messages, "\n")
+	t.mu.Unlock()
+	t.finish(statusFail, message)
+	failures.Add(1)
+	runtime.Goexit()
+}
+
+// finish records the result of the test case, logging its duration if verbose.
+func (t *TB) finish(status, message string) {
+	duration := time.Since(t.start)
+	if verbose {
+		log.Printf(logPrefix("TIME: ")+"%s took %v", t.name, duration)
+	}
+	defaultReport.add(Result{
+		Name:       t.name,
+		Iteration:  iteration,
+		Status:     status,
+		DurationMS: durationMS(duration),
+		Message:    message,
+	})
 }
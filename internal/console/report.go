@@ -0,0 +1,87 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package console
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	statusPass = "pass"
+	statusFail = "fail"
+)
+
+// Result is the result of a test case, as written to the JSON report. The
+// iteration is only set when the suite runs more than once.
+type Result struct {
+	Name       string  `json:"name"`
+	Iteration  int     `json:"iteration,omitempty"`
+	Status     string  `json:"status"`
+	DurationMS float64 `json:"duration_ms"`
+	Message    string  `json:"message,omitempty"`
+}
+
+type report struct {
+	mu      sync.Mutex
+	path    string
+	results []Result
+}
+
+// The TBs are created independently by each test case, so the results are
+// collected in a single report for the process.
+var defaultReport = &report{} // nolint:gochecknoglobals
+
+// EnableReport records the results of all test cases run after it's called,
+// to be written as JSON to the given path by WriteReport.
+func EnableReport(path string) {
+	defaultReport.mu.Lock()
+	defer defaultReport.mu.Unlock()
+	defaultReport.path = path
+}
+
+// WriteReport writes the results recorded so far to the path given to
+// EnableReport. It's a no-op if reporting isn't enabled.
+func WriteReport() error {
+	defaultReport.mu.Lock()
+	defer defaultReport.mu.Unlock()
+	if defaultReport.path == "" {
+		return nil
+	}
+	results := defaultReport.results
+	if results == nil {
+		results = []Result{}
+	}
+	bytes, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(defaultReport.path, append(bytes, '\n'), 0600)
+}
+
+func (r *report) add(result Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.path == "" {
+		return
+	}
+	r.results = append(r.results, result)
+}
+
+func durationMS(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
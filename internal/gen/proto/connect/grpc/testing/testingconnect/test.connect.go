@@ -58,6 +58,9 @@ type TestServiceClient interface {
 	UnaryCall(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
 	// One request followed by one response. This RPC always fails.
 	FailUnaryCall(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
+	// One request followed by one response. This RPC always fails with error
+	// details of well-known types.
+	FailUnaryCallWithDetails(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
 	// One request followed by one response. Response has cache control
 	// headers set such that a caching HTTP proxy (such as GFE) can
 	// satisfy subsequent requests.
@@ -112,6 +115,11 @@ func NewTestServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+"/grpc.testing.TestService/FailUnaryCall",
 			opts...,
 		),
+		failUnaryCallWithDetails: connect_go.NewClient[testing.SimpleRequest, testing.SimpleResponse](
+			httpClient,
+			baseURL+"/grpc.testing.TestService/FailUnaryCallWithDetails",
+			opts...,
+		),
 		cacheableUnaryCall: connect_go.NewClient[testing.SimpleRequest, testing.SimpleResponse](
 			httpClient,
 			baseURL+"/grpc.testing.TestService/CacheableUnaryCall",
@@ -160,6 +168,7 @@ type testServiceClient struct {
 	emptyCall                        *connect_go.Client[testing.Empty, testing.Empty]
 	unaryCall                        *connect_go.Client[testing.SimpleRequest, testing.SimpleResponse]
 	failUnaryCall                    *connect_go.Client[testing.SimpleRequest, testing.SimpleResponse]
+	failUnaryCallWithDetails         *connect_go.Client[testing.SimpleRequest, testing.SimpleResponse]
 	cacheableUnaryCall               *connect_go.Client[testing.SimpleRequest, testing.SimpleResponse]
 	streamingOutputCall              *connect_go.Client[testing.StreamingOutputCallRequest, testing.StreamingOutputCallResponse]
 	failStreamingOutputCall          *connect_go.Client[testing.StreamingOutputCallRequest, testing.StreamingOutputCallResponse]
@@ -185,6 +194,11 @@ func (c *testServiceClient) FailUnaryCall(ctx context.Context, req *connect_go.R
 	return c.failUnaryCall.CallUnary(ctx, req)
 }
 
+// FailUnaryCallWithDetails calls grpc.testing.TestService.FailUnaryCallWithDetails.
+func (c *testServiceClient) FailUnaryCallWithDetails(ctx context.Context, req *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error) {
+	return c.failUnaryCallWithDetails.CallUnary(ctx, req)
+}
+
 // CacheableUnaryCall calls grpc.testing.TestService.CacheableUnaryCall.
 func (c *testServiceClient) CacheableUnaryCall(ctx context.Context, req *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error) {
 	return c.cacheableUnaryCall.CallUnary(ctx, req)
@@ -233,6 +247,9 @@ type TestServiceHandler interface {
 	UnaryCall(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
 	// One request followed by one response. This RPC always fails.
 	FailUnaryCall(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
+	// One request followed by one response. This RPC always fails with error
+	// details of well-known types.
+	FailUnaryCallWithDetails(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error)
 	// One request followed by one response. Response has cache control
 	// headers set such that a caching HTTP proxy (such as GFE) can
 	// satisfy subsequent requests.
@@ -284,6 +301,11 @@ func NewTestServiceHandler(svc TestServiceHandler, opts ...connect_go.HandlerOpt
 		svc.FailUnaryCall,
 		opts...,
 	))
+	mux.Handle("/grpc.testing.TestService/FailUnaryCallWithDetails", connect_go.NewUnaryHandler(
+		"/grpc.testing.TestService/FailUnaryCallWithDetails",
+		svc.FailUnaryCallWithDetails,
+		opts...,
+	))
 	mux.Handle("/grpc.testing.TestService/CacheableUnaryCall", connect_go.NewUnaryHandler(
 		"/grpc.testing.TestService/CacheableUnaryCall",
 		svc.CacheableUnaryCall,
@@ -342,6 +364,10 @@ func (UnimplementedTestServiceHandler) FailUnaryCall(context.Context, *connect_g
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("grpc.testing.TestService.FailUnaryCall is not implemented"))
 }
 
+func (UnimplementedTestServiceHandler) FailUnaryCallWithDetails(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("grpc.testing.TestService.FailUnaryCallWithDetails is not implemented"))
+}
+
 func (UnimplementedTestServiceHandler) CacheableUnaryCall(context.Context, *connect_go.Request[testing.SimpleRequest]) (*connect_go.Response[testing.SimpleResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("grpc.testing.TestService.CacheableUnaryCall is not implemented"))
 }
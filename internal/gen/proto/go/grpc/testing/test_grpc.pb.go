@@ -42,6 +42,9 @@ type TestServiceClient interface {
 	UnaryCall(ctx context.Context, in *SimpleRequest, opts ...grpc.CallOption) (*SimpleResponse, error)
 	// One request followed by one response. This RPC always fails.
 	FailUnaryCall(ctx context.Context, in *SimpleRequest, opts ...grpc.CallOption) (*SimpleResponse, error)
+	// One request followed by one response. This RPC always fails with error
+	// details of well-known types.
+	FailUnaryCallWithDetails(ctx context.Context, in *SimpleRequest, opts ...grpc.CallOption) (*SimpleResponse, error)
 	// One request followed by one response. Response has cache control
 	// headers set such that a caching HTTP proxy (such as GFE) can
 	// satisfy subsequent requests.
@@ -106,6 +109,15 @@ func (c *testServiceClient) FailUnaryCall(ctx context.Context, in *SimpleRequest
 	return out, nil
 }
 
+func (c *testServiceClient) FailUnaryCallWithDetails(ctx context.Context, in *SimpleRequest, opts ...grpc.CallOption) (*SimpleResponse, error) {
+	out := new(SimpleResponse)
+	err := c.cc.Invoke(ctx, "/grpc.testing.TestService/FailUnaryCallWithDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *testServiceClient) CacheableUnaryCall(ctx context.Context, in *SimpleRequest, opts ...grpc.CallOption) (*SimpleResponse, error) {
 	out := new(SimpleResponse)
 	err := c.cc.Invoke(ctx, "/grpc.testing.TestService/CacheableUnaryCall", in, out, opts...)
@@ -326,6 +338,9 @@ type TestServiceServer interface {
 	UnaryCall(context.Context, *SimpleRequest) (*SimpleResponse, error)
 	// One request followed by one response. This RPC always fails.
 	FailUnaryCall(context.Context, *SimpleRequest) (*SimpleResponse, error)
+	// One request followed by one response. This RPC always fails with error
+	// details of well-known types.
+	FailUnaryCallWithDetails(context.Context, *SimpleRequest) (*SimpleResponse, error)
 	// One request followed by one response. Response has cache control
 	// headers set such that a caching HTTP proxy (such as GFE) can
 	// satisfy subsequent requests.
@@ -369,6 +384,9 @@ func (UnimplementedTestServiceServer) UnaryCall(context.Context, *SimpleRequest)
 func (UnimplementedTestServiceServer) FailUnaryCall(context.Context, *SimpleRequest) (*SimpleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FailUnaryCall not implemented")
 }
+func (UnimplementedTestServiceServer) FailUnaryCallWithDetails(context.Context, *SimpleRequest) (*SimpleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailUnaryCallWithDetails not implemented")
+}
 func (UnimplementedTestServiceServer) CacheableUnaryCall(context.Context, *SimpleRequest) (*SimpleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CacheableUnaryCall not implemented")
 }
@@ -460,6 +478,24 @@ func _TestService_FailUnaryCall_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TestService_FailUnaryCallWithDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimpleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestServiceServer).FailUnaryCallWithDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.testing.TestService/FailUnaryCallWithDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestServiceServer).FailUnaryCallWithDetails(ctx, req.(*SimpleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TestService_CacheableUnaryCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimpleRequest)
 	if err := dec(in); err != nil {
@@ -656,6 +692,10 @@ var TestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FailUnaryCall",
 			Handler:    _TestService_FailUnaryCall_Handler,
 		},
+		{
+			MethodName: "FailUnaryCallWithDetails",
+			Handler:    _TestService_FailUnaryCallWithDetails_Handler,
+		},
 		{
 			MethodName: "CacheableUnaryCall",
 			Handler:    _TestService_CacheableUnaryCall_Handler,
@@ -14,13 +14,310 @@
 
 package interop
 
-import testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	orcav3 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/xds/data/orca/v3"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
 
 // NonASCIIErrMsg is a non-ASCII error message.
 const NonASCIIErrMsg = "soirée 🎉" // readable non-ASCII
 
+// ServerReadMaxBytes is the maximum size of a message the test servers read,
+// which matches the default limit of grpc-go servers.
+const ServerReadMaxBytes = 4 * 1024 * 1024
+
+// ParseTLSVersion parses a TLS version given as "1.2" or "1.3", the versions
+// the servers and clients can be limited to.
+func ParseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid TLS version %q, must be 1.2 or 1.3", version)
+	}
+}
+
 // ErrorDetail is an error detail to be included in an error.
 var ErrorDetail = &testpb.ErrorDetail{
 	Reason: NonASCIIErrMsg,
 	Domain: "connect-crosstest",
 }
+
+// QuotaErrMsg is the message of the error returned with the RetryInfo and
+// QuotaFailure error details.
+const QuotaErrMsg = "quota exhausted"
+
+// RetryInfo is a well-known error detail to be included in an error.
+var RetryInfo = &errdetails.RetryInfo{
+	RetryDelay: durationpb.New(1500 * time.Millisecond),
+}
+
+// QuotaFailure is a well-known error detail to be included in an error.
+var QuotaFailure = &errdetails.QuotaFailure{
+	Violations: []*errdetails.QuotaFailure_Violation{
+		{
+			Subject:     "project:connect-crosstest",
+			Description: "daily limit exceeded",
+		},
+	},
+}
+
+// Version is the version of the test servers, stamped on their responses with
+// the VersionKey header. It's set at build time with
+// -ldflags "-X github.com/bufbuild/connect-crosstest/internal/interop.Version=<version>".
+var Version = "dev" // nolint:gochecknoglobals
+
+// VersionKey is the header the test servers set to their Version on every
+// response, so that a failing run can be traced back to a server build.
+const VersionKey = "x-connect-crosstest-version"
+
+// AuthorizationKey is the header carrying the bearer token of a request.
+const AuthorizationKey = "authorization"
+
+// BearerToken returns the value of the authorization header for the token.
+func BearerToken(token string) string {
+	return "Bearer " + token
+}
+
+// CheckBearerToken checks that the value of the authorization header of a
+// request carries the expected bearer token.
+func CheckBearerToken(authorization, token string) error {
+	if authorization == "" {
+		return errors.New("missing bearer token")
+	}
+	if subtle.ConstantTimeCompare([]byte(authorization), []byte(BearerToken(token))) != 1 {
+		return errors.New("invalid bearer token")
+	}
+	return nil
+}
+
+// FailAfterResponsesKey is the header asking FailStreamingOutputCall to send
+// the requested responses before failing. Without it, the call fails right
+// away.
+const FailAfterResponsesKey = "x-grpc-test-fail-after-responses"
+
+// AbortClientStreamBody is the body of a payload asking StreamingInputCall to
+// fail the call with the aborted code and AbortClientStreamErrMsg as soon as
+// it's received, without reading the rest of the requests.
+const AbortClientStreamBody = "x-grpc-test-abort-client-stream"
+
+// AbortClientStreamErrMsg is the message of the error StreamingInputCall fails
+// with when it receives the AbortClientStreamBody payload.
+const AbortClientStreamErrMsg = "client stream aborted by the server"
+
+// CacheControl is the cache control header the test servers set on the
+// responses of CacheableUnaryCall, so that a caching HTTP proxy can satisfy
+// subsequent requests.
+const CacheControl = "max-age=60, public"
+
+// NewCacheablePayload returns the payload of CacheableUnaryCall responses. It
+// contains the current time, so that a cached response can be told apart from
+// a fresh one.
+func NewCacheablePayload() *testpb.Payload {
+	return &testpb.Payload{
+		Type: testpb.PayloadType_COMPRESSABLE,
+		Body: []byte(strconv.FormatInt(time.Now().UnixNano(), 10)),
+	}
+}
+
+// SequencePayloadKey is the header asking StreamingOutputCall to embed the
+// index of each response in its payload, so that clients can check that the
+// responses are received in order.
+const SequencePayloadKey = "x-grpc-test-sequence-payload"
+
+// sequenceSize is the size of the index embedded in payload bodies.
+const sequenceSize = 8
+
+// PutSequence embeds the index in the first bytes of the payload body.
+func PutSequence(body []byte, index int) error {
+	if len(body) < sequenceSize {
+		return fmt.Errorf("payload of %d bytes is too small for a sequence of %d bytes", len(body), sequenceSize)
+	}
+	binary.BigEndian.PutUint64(body, uint64(index))
+	return nil
+}
+
+// Sequence returns the index embedded in the payload body by PutSequence.
+func Sequence(body []byte) (int, error) {
+	if len(body) < sequenceSize {
+		return 0, fmt.Errorf("payload of %d bytes is too small for a sequence of %d bytes", len(body), sequenceSize)
+	}
+	return int(binary.BigEndian.Uint64(body)), nil
+}
+
+// UnarySleepKey is the header asking UnaryCall to sleep for the duration it
+// carries before responding, so that clients can check that the deadline they
+// set is enforced by the server.
+const UnarySleepKey = "x-grpc-test-unary-sleep"
+
+// FirstResponseDelayKey is the header asking FullDuplexCall to wait for the
+// duration it carries after setting the response headers and before sending
+// any response, so that clients can check when the headers become available.
+const FirstResponseDelayKey = "x-grpc-test-first-response-delay"
+
+// HeadersFlushedKey is the response header FullDuplexCall sets when it flushes
+// the response headers before waiting for the FirstResponseDelayKey duration,
+// so that clients know whether to expect the headers before the first response
+// or with it.
+const HeadersFlushedKey = "x-grpc-test-headers-flushed"
+
+// Sleep pauses for the given duration, returning the error of the context
+// instead if it's done before the duration elapses.
+func Sleep(ctx context.Context, duration time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// FailAttemptsKey is the header asking UnaryCall to fail with UNAVAILABLE for
+// the number of attempts it carries, so that clients can check that they retry
+// the call. The attempts are counted with the PreviousAttemptsKey header of
+// the retries, which keeps the servers stateless.
+const FailAttemptsKey = "x-grpc-test-fail-attempts"
+
+// PreviousAttemptsKey is the header carrying the number of previous attempts
+// of a retried call, as set by gRPC clients.
+const PreviousAttemptsKey = "grpc-previous-rpc-attempts"
+
+// ParseAttempt parses the values of the FailAttemptsKey and PreviousAttemptsKey
+// headers of a request, returning the number of the attempt, starting at 1, and
+// whether it should fail.
+func ParseAttempt(failAttempts, previousAttempts string) (int, bool, error) {
+	fail, err := strconv.Atoi(failAttempts)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s header: %w", FailAttemptsKey, err)
+	}
+	previous := 0
+	if previousAttempts != "" {
+		previous, err = strconv.Atoi(previousAttempts)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %s header: %w", PreviousAttemptsKey, err)
+		}
+	}
+	attempt := previous + 1
+	return attempt, attempt <= fail, nil
+}
+
+// FailedAttemptErrMsg is the message of the error UnaryCall fails the attempt
+// with.
+func FailedAttemptErrMsg(attempt int) string {
+	return fmt.Sprintf("failing attempt %d as requested", attempt)
+}
+
+// TrailerCountKey is the header asking UnaryCall to attach the number of
+// distinct trailers it carries to the response, as returned by NewTrailers, so
+// that clients can check that many trailers are received without truncation.
+const TrailerCountKey = "x-grpc-test-trailer-count"
+
+// TrailerSizeKey is the header setting the size of the values of the trailers
+// requested with TrailerCountKey. Without it, the values are short.
+const TrailerSizeKey = "x-grpc-test-trailer-size"
+
+const (
+	// maxTrailerCount is the most trailers that can be requested with
+	// TrailerCountKey, so that a request can't make the server build an
+	// unbounded response.
+	maxTrailerCount = 1000
+	// maxTrailerSize is the largest size of the values that can be requested
+	// with TrailerSizeKey.
+	maxTrailerSize = 64 * 1024
+)
+
+// ParseTrailers returns the trailers requested with the values of the
+// TrailerCountKey and TrailerSizeKey headers of a request. It fails for more
+// than 1000 trailers or values over 64 KiB, which servers return as an invalid
+// argument.
+func ParseTrailers(count, size string) (map[string]string, error) {
+	trailerCount, err := strconv.Atoi(count)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header: %w", TrailerCountKey, err)
+	}
+	if trailerCount < 0 || trailerCount > maxTrailerCount {
+		return nil, fmt.Errorf("invalid %s header: %d is not between 0 and %d", TrailerCountKey, trailerCount, maxTrailerCount)
+	}
+	trailerSize := 0
+	if size != "" {
+		trailerSize, err = strconv.Atoi(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header: %w", TrailerSizeKey, err)
+		}
+		if trailerSize < 0 || trailerSize > maxTrailerSize {
+			return nil, fmt.Errorf("invalid %s header: %d is not between 0 and %d", TrailerSizeKey, trailerSize, maxTrailerSize)
+		}
+	}
+	return NewTrailers(trailerCount, trailerSize), nil
+}
+
+// NewTrailers returns the given number of distinct trailers, keyed by index,
+// with values padded to the given size. Requested counts and sizes must be
+// checked with ParseTrailers first.
+func NewTrailers(count, size int) map[string]string {
+	trailers := make(map[string]string, count)
+	for i := 0; i < count; i++ {
+		value := fmt.Sprintf("value-%d-", i)
+		if len(value) < size {
+			value += strings.Repeat("x", size-len(value))
+		}
+		trailers[fmt.Sprintf("x-grpc-test-trailer-%d", i)] = value
+	}
+	return trailers
+}
+
+// largeBinaryTrailerSize is the size of the payload body serialized by
+// NewLargeBinaryTrailer.
+const largeBinaryTrailerSize = 4096
+
+// NewLargeBinaryTrailer returns a binary metadata value of about 4 KiB: a
+// serialized payload whose body repeats all 256 byte values, so that encoding
+// bugs for any byte are caught.
+func NewLargeBinaryTrailer() ([]byte, error) {
+	body := make([]byte, largeBinaryTrailerSize)
+	for i := range body {
+		body[i] = byte(i)
+	}
+	return proto.Marshal(&testpb.Payload{
+		Type: testpb.PayloadType_COMPRESSABLE,
+		Body: body,
+	})
+}
+
+// OrcaLoadReportKey is the trailer the test servers attach the ORCA load
+// report of an RPC to.
+const OrcaLoadReportKey = "endpoint-load-metrics-bin"
+
+// NewOrcaLoadReport returns the ORCA load report for the metrics a client asks
+// the test servers to record.
+func NewOrcaLoadReport(report *testpb.TestOrcaReport) *orcav3.OrcaLoadReport {
+	return &orcav3.OrcaLoadReport{
+		CpuUtilization: report.GetCpuUtilization(),
+		MemUtilization: report.GetMemoryUtilization(),
+		RequestCost:    report.GetRequestCost(),
+		Utilization:    report.GetUtilization(),
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/crosstesting"
+	connectpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	"github.com/bufbuild/connect-go"
+)
+
+// ClientTestCase is a test case that only needs a client of the test service,
+// so that it runs the same with each protocol, against any Connect test server.
+type ClientTestCase struct {
+	// Name is the name of the test case description of the README, suffixed by
+	// the RPC when a description covers several.
+	Name string
+	// StreamType decides whether the test case needs a transport streaming the
+	// request and the response at the same time, which HTTP/1.1 doesn't support.
+	StreamType connect.StreamType
+	// LargeTrailers is set for the test cases receiving trailers over 4 KiB,
+	// which net/http clients don't read from HTTP/1.1 responses.
+	LargeTrailers bool
+	// Serial is set for the test cases bounding how long calls take, which are
+	// meant to run alone, so that concurrent test cases don't slow their calls.
+	Serial bool
+	// Run runs the test case with the client.
+	Run func(crosstesting.TB, connectpb.TestServiceClient)
+}
+
+// ClientTestCases returns the test cases that only need a client of the test
+// service, run by the crosstest command and by the in-process Go tests. The
+// server streaming and ping-pong test cases ask the server to wait for the
+// stream message delay before sending each response.
+func ClientTestCases(streamMessageDelay time.Duration) []ClientTestCase {
+	return []ClientTestCase{
+		{Name: "empty_unary", StreamType: connect.StreamTypeUnary, Run: DoEmptyUnaryCall},
+		{Name: "empty_unary_with_headers", StreamType: connect.StreamTypeUnary, Run: DoEmptyUnaryCallWithHeaders},
+		{Name: "server_version", StreamType: connect.StreamTypeUnary, Run: DoServerVersion},
+		{Name: "large_unary", StreamType: connect.StreamTypeUnary, Run: DoLargeUnaryCall},
+		{Name: "large_unary_bidirectional_sizes", StreamType: connect.StreamTypeUnary, Run: DoLargeUnaryCallBidirectionalSizes},
+		{Name: "cacheable_unary", StreamType: connect.StreamTypeUnary, Run: DoCacheableUnaryCall},
+		{Name: "boundary_size_unary", StreamType: connect.StreamTypeUnary, Run: DoBoundarySizeUnary},
+		{Name: "exceeds_server_message_size_limit", StreamType: connect.StreamTypeUnary, Run: DoExceedsServerMessageSizeLimit},
+		{Name: "deadline_propagation", StreamType: connect.StreamTypeUnary, Serial: true, Run: DoDeadlinePropagation},
+		{Name: "server_side_deadline_propagation", StreamType: connect.StreamTypeUnary, Run: DoUnaryWithServerSideContextDeadlinePropagation},
+		{Name: "custom_metadata_unary", StreamType: connect.StreamTypeUnary, Run: DoCustomMetadataUnary},
+		{Name: "duplicated_custom_metadata_unary", StreamType: connect.StreamTypeUnary, Run: DoDuplicatedCustomMetadataUnary},
+		{Name: "repeated_metadata_unary", StreamType: connect.StreamTypeUnary, Run: DoRepeatedMetadata},
+		{Name: "unary_header_and_trailer_echo", StreamType: connect.StreamTypeUnary, Run: DoUnaryWithResponseHeaderAndTrailerEcho},
+		{Name: "many_trailers", StreamType: connect.StreamTypeUnary, Run: DoManyTrailers},
+		{Name: "many_long_trailers", StreamType: connect.StreamTypeUnary, LargeTrailers: true, Run: DoManyLongTrailers},
+		{Name: "metadata_in_trailer_only", StreamType: connect.StreamTypeUnary, Run: DoRequestResponseWithMetadataInTrailerOnly},
+		{Name: "oversized_metadata", StreamType: connect.StreamTypeUnary, Run: DoOversizedMetadata},
+		{Name: "binary_metadata", StreamType: connect.StreamTypeUnary, Run: DoBinaryMetadata},
+		{Name: "large_metadata_binary_trailer", StreamType: connect.StreamTypeUnary, LargeTrailers: true, Run: DoLargeMetadataBinaryTrailer},
+		{Name: "orca_per_rpc", StreamType: connect.StreamTypeUnary, Run: DoOrcaPerRPC},
+		{Name: "status_code_and_message_unary", StreamType: connect.StreamTypeUnary, Run: DoStatusCodeAndMessageUnary},
+		{Name: "all_status_codes", StreamType: connect.StreamTypeUnary, Run: DoAllStatusCodes},
+		{Name: "unary_with_invalid_argument", StreamType: connect.StreamTypeUnary, Run: DoUnaryWithInvalidArgument},
+		{Name: "response_status_with_trailing_metadata", StreamType: connect.StreamTypeUnary, Run: DoResponseStatusWithTrailingMetadata},
+		{Name: "special_status_message", StreamType: connect.StreamTypeUnary, Run: DoSpecialStatusMessage},
+		{Name: "unimplemented_method", StreamType: connect.StreamTypeUnary, Run: DoUnimplementedMethod},
+		{Name: "fail_unary", StreamType: connect.StreamTypeUnary, Run: DoFailWithNonASCIIError},
+		{Name: "error_with_details", StreamType: connect.StreamTypeUnary, Run: DoErrorWithDetails},
+		{Name: "server_streaming", StreamType: connect.StreamTypeServer, Run: func(t crosstesting.TB, client connectpb.TestServiceClient) {
+			DoServerStreaming(t, client, streamMessageDelay)
+		}},
+		{Name: "server_streaming_large_message_count", StreamType: connect.StreamTypeServer, Run: DoServerStreamingLargeMessageCount},
+		{Name: "server_streaming_zero_interval_flood", StreamType: connect.StreamTypeServer, Run: DoServerStreamingZeroIntervalFlood},
+		{Name: "server_streaming_with_slow_consumer", StreamType: connect.StreamTypeServer, Run: DoServerStreamingWithSlowConsumer},
+		{Name: "empty_stream_server_streaming", StreamType: connect.StreamTypeServer, Run: DoEmptyStreamServerStreaming},
+		{Name: "deadline_exceeded_server_streaming", StreamType: connect.StreamTypeServer, Run: DoDeadlineExceededServerStreaming},
+		{Name: "cancel_after_begin_server_streaming", StreamType: connect.StreamTypeServer, Run: DoCancelAfterBeginServerStreaming},
+		{Name: "cancel_during_server_streaming", StreamType: connect.StreamTypeServer, Serial: true, Run: DoCancelDuringServerStreaming},
+		{Name: "cancel_after_first_response_server_streaming", StreamType: connect.StreamTypeServer, Run: DoCancelAfterFirstResponseServerStreaming},
+		{Name: "custom_metadata_server_streaming", StreamType: connect.StreamTypeServer, Run: DoCustomMetadataServerStreaming},
+		{Name: "duplicated_custom_metadata_server_streaming", StreamType: connect.StreamTypeServer, Run: DoDuplicatedCustomMetadataServerStreaming},
+		{Name: "unimplemented_server_streaming_method", StreamType: connect.StreamTypeServer, Run: DoUnimplementedServerStreamingMethod},
+		{Name: "fail_server_streaming", StreamType: connect.StreamTypeServer, Run: DoFailServerStreamingWithNonASCIIError},
+		{Name: "fail_server_streaming_after_responses", StreamType: connect.StreamTypeServer, Run: DoFailServerStreaming},
+		{Name: "status_code_and_message_server_streaming", StreamType: connect.StreamTypeServer, Run: DoStatusCodeAndMessageServerStreaming},
+		{Name: "server_streaming_early_error", StreamType: connect.StreamTypeServer, Run: DoServerStreamingEarlyError},
+		{Name: "server_streaming_with_invalid_argument", StreamType: connect.StreamTypeServer, Run: DoServerStreamingWithInvalidArgument},
+		{Name: "full_duplex_with_invalid_argument", StreamType: connect.StreamTypeBidi, Run: DoFullDuplexWithInvalidArgument},
+		{Name: "client_streaming", StreamType: connect.StreamTypeClient, Run: DoClientStreaming},
+		{Name: "streaming_input_call_large_aggregate", StreamType: connect.StreamTypeClient, Run: DoStreamingInputCallLargeAggregate},
+		{Name: "graceful_stream_half_close", StreamType: connect.StreamTypeClient, Run: DoGracefulStreamHalfClose},
+		{Name: "client_streaming_with_server_error", StreamType: connect.StreamTypeClient, Run: DoClientStreamingWithServerError},
+		{Name: "streaming_input_call_empty_payload", StreamType: connect.StreamTypeClient, Run: DoStreamingInputCallEmptyPayload},
+		{Name: "empty_stream_client_streaming", StreamType: connect.StreamTypeClient, Run: DoEmptyStreamClientStreaming},
+		{Name: "cancel_after_begin", StreamType: connect.StreamTypeClient, Run: DoCancelAfterBegin},
+		{Name: "ping_pong", StreamType: connect.StreamTypeBidi, Run: func(t crosstesting.TB, client connectpb.TestServiceClient) {
+			DoPingPong(t, client, streamMessageDelay)
+		}},
+		{Name: "interleaved_bidi_streaming", StreamType: connect.StreamTypeBidi, Run: DoInterleavedBidiStreaming},
+		{Name: "many_concurrent_streams", StreamType: connect.StreamTypeBidi, Run: DoManyConcurrentStreams},
+		{Name: "empty_stream", StreamType: connect.StreamTypeBidi, Run: DoEmptyStream},
+		{Name: "timeout_on_sleeping_server", StreamType: connect.StreamTypeBidi, Serial: true, Run: DoTimeoutOnSleepingServer},
+		{Name: "cancel_after_first_response", StreamType: connect.StreamTypeBidi, Run: DoCancelAfterFirstResponse},
+		{Name: "race_headers_and_body", StreamType: connect.StreamTypeBidi, Run: DoRaceHeadersAndBody},
+		{Name: "custom_metadata_full_duplex", StreamType: connect.StreamTypeBidi, Run: DoCustomMetadataFullDuplex},
+		{Name: "duplicated_custom_metadata_full_duplex", StreamType: connect.StreamTypeBidi, Run: DoDuplicatedCustomMetadataFullDuplex},
+		{Name: "repeated_metadata_full_duplex", StreamType: connect.StreamTypeBidi, Run: DoRepeatedMetadataFullDuplex},
+		{Name: "status_code_and_message_full_duplex", StreamType: connect.StreamTypeBidi, Run: DoStatusCodeAndMessageFullDuplex},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// NewPeerHandler wraps the handler to make the address of the peer of each
+// request available to the RPC logging interceptor.
+func NewPeerHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := context.WithValue(request.Context(), peerKey{}, request.RemoteAddr)
+		handler.ServeHTTP(writer, request.WithContext(ctx))
+	})
+}
+
+type peerKey struct{}
+
+// peerFromContext returns the address of the peer recorded by NewPeerHandler.
+func peerFromContext(ctx context.Context) (string, bool) {
+	peer, ok := ctx.Value(peerKey{}).(string)
+	return peer, ok
+}
+
+// ConnStartContext records when connections are accepted, for the handler
+// returned by NewMaxConnectionAgeHandler. It's meant to be the ConnContext of
+// an http.Server.
+func ConnStartContext(ctx context.Context, _ net.Conn) context.Context {
+	return context.WithValue(ctx, connStartKey{}, time.Now())
+}
+
+// NewMaxConnectionAgeHandler wraps the handler to close the connections older
+// than maxAge, by setting "Connection: close" on the responses to their
+// requests. HTTP/1.1 connections are then closed after the response, and
+// HTTP/2 connections are sent a GOAWAY, letting their in-flight streams
+// complete. Connections are only closed on requests, since net/http doesn't
+// expose connections to close them on a timer. Requests on connections not
+// recorded by ConnStartContext, like HTTP/3 ones, are left as is.
+func NewMaxConnectionAgeHandler(handler http.Handler, maxAge time.Duration) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if start, ok := request.Context().Value(connStartKey{}).(time.Time); ok && time.Since(start) > maxAge {
+			writer.Header().Set("Connection", "close")
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+type connStartKey struct{}
@@ -0,0 +1,174 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+)
+
+const (
+	grpcWebContentTypePrefix     = "application/grpc-web"
+	grpcWebTextContentTypePrefix = "application/grpc-web-text"
+)
+
+// GRPCWebTextTransport is an http.RoundTripper that converts the requests of
+// gRPC-Web clients to the text format of gRPC-Web, which base64-encodes the
+// frames, and converts the responses back to the binary format. connect-go
+// only supports the binary format, so the transport lets its gRPC-Web client
+// test servers and proxies that support the text format.
+type GRPCWebTextTransport struct {
+	transport http.RoundTripper
+}
+
+// NewGRPCWebTextTransport returns a GRPCWebTextTransport wrapping the given
+// transport.
+func NewGRPCWebTextTransport(transport http.RoundTripper) *GRPCWebTextTransport {
+	return &GRPCWebTextTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *GRPCWebTextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	contentType := request.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, grpcWebContentTypePrefix) {
+		return t.transport.RoundTrip(request)
+	}
+	request = request.Clone(request.Context())
+	request.Header.Set("Content-Type", grpcWebTextContentTypePrefix+strings.TrimPrefix(contentType, grpcWebContentTypePrefix))
+	request.Header.Set("Accept", grpcWebTextContentTypePrefix)
+	if request.Body != nil {
+		request.Body = newBase64EncodingReader(request.Body)
+		request.ContentLength = -1
+	}
+	response, err := t.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	responseContentType := response.Header.Get("Content-Type")
+	if strings.HasPrefix(responseContentType, grpcWebTextContentTypePrefix) {
+		response.Header.Set("Content-Type", grpcWebContentTypePrefix+strings.TrimPrefix(responseContentType, grpcWebTextContentTypePrefix))
+		response.Body = &base64DecodingReader{body: response.Body}
+		response.ContentLength = -1
+	}
+	return response, nil
+}
+
+// SupportsGRPCWebText probes whether the server at the base URL supports the
+// text format of gRPC-Web, by calling EmptyCall with a text request and
+// checking that the response is in the text format too. Servers that don't
+// support it, such as connect-go servers, respond with an unsupported media
+// type error instead.
+func SupportsGRPCWebText(ctx context.Context, httpClient connect.HTTPClient, baseURL string) (bool, error) {
+	// an empty message is sent as an envelope with no flags and a zero length
+	body := base64.StdEncoding.EncodeToString(make([]byte, 5))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/grpc.testing.TestService/EmptyCall", strings.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", grpcWebTextContentTypePrefix+"+proto")
+	request.Header.Set("Accept", grpcWebTextContentTypePrefix)
+	request.Header.Set("X-Grpc-Web", "1")
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if _, err := io.Copy(io.Discard, response.Body); err != nil {
+		return false, err
+	}
+	if response.StatusCode == http.StatusUnsupportedMediaType {
+		return false, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected HTTP status %d probing for gRPC-Web text support", response.StatusCode)
+	}
+	return strings.HasPrefix(response.Header.Get("Content-Type"), grpcWebTextContentTypePrefix), nil
+}
+
+// newBase64EncodingReader returns a reader of the base64 encoding of the body,
+// encoding it as it's read.
+func newBase64EncodingReader(body io.ReadCloser) io.ReadCloser {
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		encoder := base64.NewEncoder(base64.StdEncoding, pipeWriter)
+		_, err := io.Copy(encoder, body)
+		if closeErr := encoder.Close(); err == nil {
+			err = closeErr
+		}
+		_ = body.Close()
+		_ = pipeWriter.CloseWithError(err)
+	}()
+	return pipeReader
+}
+
+// base64DecodingReader decodes a base64-encoded body. Servers may encode each
+// chunk of the body separately, so padding can appear in the middle of the
+// body. Since padding only ever completes a 4 byte quantum, the body is
+// decoded one quantum at a time.
+type base64DecodingReader struct {
+	body    io.ReadCloser
+	encoded []byte
+	decoded bytes.Buffer
+	err     error
+}
+
+// Read fills the data unless the body ends first, since connect-go reads the
+// prefix of each envelope with a single Read, and a decoded chunk may end in
+// the middle of the prefix.
+func (r *base64DecodingReader) Read(data []byte) (int, error) {
+	for r.decoded.Len() < len(data) && r.err == nil {
+		r.fill()
+	}
+	if r.decoded.Len() > 0 {
+		return r.decoded.Read(data)
+	}
+	return 0, r.err
+}
+
+func (r *base64DecodingReader) Close() error {
+	return r.body.Close()
+}
+
+// fill reads from the body and decodes all the complete quanta read so far.
+func (r *base64DecodingReader) fill() {
+	buffer := make([]byte, 4096)
+	n, err := r.body.Read(buffer)
+	r.encoded = append(r.encoded, buffer[:n]...)
+	quanta := len(r.encoded) / 4 * 4
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(4))
+	for i := 0; i < quanta; i += 4 {
+		n, decodeErr := base64.StdEncoding.Decode(decoded, r.encoded[i:i+4])
+		if decodeErr != nil {
+			r.err = decodeErr
+			return
+		}
+		r.decoded.Write(decoded[:n])
+	}
+	r.encoded = r.encoded[quanta:]
+	if errors.Is(err, io.EOF) && len(r.encoded) > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	r.err = err
+}
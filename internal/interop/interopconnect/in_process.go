@@ -0,0 +1,208 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
+	"github.com/bufbuild/connect-go"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// NewInProcessClient starts the TestService handler, with the handler options
+// of the Connect test server, on an httptest server serving HTTP/2 without TLS,
+// and returns a client of it, so that the test cases can run as Go tests. The
+// client options select the protocol, which defaults to Connect. Panics in the
+// handler fail the test, and the server is closed when the test completes. The
+// test then fails if the goroutines it started are still running, as checked
+// by checkLeakedGoroutines.
+func NewInProcessClient(t testing.TB, options ...connect.ClientOption) testingconnect.TestServiceClient {
+	t.Helper()
+	httpClient, url := newInProcessServer(t)
+	return testingconnect.NewTestServiceClient(httpClient, url, options...)
+}
+
+// newInProcessServer starts the server of NewInProcessClient, and returns an
+// HTTP client of it and its URL, for tests sending requests by hand.
+func newInProcessServer(t testing.TB) (*http.Client, string) {
+	t.Helper()
+	checkLeakedGoroutines(t)
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(
+		NewTestServiceHandler(0),
+		compression.WithZstd(),
+		compression.WithDeflate(),
+		compression.WithBrotli(),
+		connect.WithReadMaxBytes(interop.ServerReadMaxBytes),
+		connect.WithInterceptors(NewVersionInterceptor(interop.Version)),
+	))
+	server := httptest.NewServer(h2c.NewHandler(NewPeerHandler(recoverHandler(t, mux)), &http2.Server{}))
+	t.Cleanup(server.Close)
+	transport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}
+	// cleanups run last-in first-out, so idle connections are closed before the
+	// server, which waits for them
+	t.Cleanup(transport.CloseIdleConnections)
+	return &http.Client{Transport: transport}, server.URL
+}
+
+// recoverHandler reports panics in the handler as failures of the test, since
+// the HTTP/2 server would otherwise only log them and reset the stream, which
+// the client can't tell apart from other errors.
+func recoverHandler(t testing.TB, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		defer func() {
+			if recovered := recover(); recovered != nil && recovered != http.ErrAbortHandler {
+				t.Errorf("handler of %s panicked: %v\n%s", request.URL.Path, recovered, debug.Stack())
+				// the failure is already reported, so the stream is aborted without
+				// logging the panic again
+				panic(http.ErrAbortHandler)
+			}
+		}()
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+// goroutineSettleTimeout is how long the goroutines started by a test have to
+// return once the test is done, since the HTTP/2 servers and transports close
+// their connections in the background.
+const goroutineSettleTimeout = 5 * time.Second
+
+// testLabelKey is the key of the profiler label naming the test that started a
+// goroutine. Goroutines inherit the labels of the goroutine starting them, so
+// the goroutines of each test can be told apart from those of the tests running
+// in parallel.
+const testLabelKey = "interopconnect-test"
+
+// ignoredTopFunctionPrefixes are the prefixes of the top functions of the
+// goroutines that aren't leaks, like the one started by os/signal.Notify when
+// fuzzing, which runs as long as the process.
+var ignoredTopFunctionPrefixes = []string{"os/signal."} // nolint:gochecknoglobals
+
+// checkedTests are the tests whose goroutines are already checked, since the
+// in-process server can be started several times by the same test.
+var checkedTests sync.Map // nolint:gochecknoglobals
+
+// checkLeakedGoroutines labels the goroutine of the test with its name, and
+// fails the test if goroutines started with the label are still running once
+// the test and its other cleanups are done. It must be called before the test
+// starts goroutines or registers the cleanups stopping them, since cleanups run
+// last-in first-out.
+func checkLeakedGoroutines(t testing.TB) {
+	t.Helper()
+	if _, loaded := checkedTests.LoadOrStore(t, struct{}{}); loaded {
+		return
+	}
+	name := t.Name()
+	pprof.SetGoroutineLabels(pprof.WithLabels(context.Background(), pprof.Labels(testLabelKey, name)))
+	t.Cleanup(func() {
+		defer checkedTests.Delete(t)
+		if count, stacks := leakedGoroutines(name, goroutineSettleTimeout); count > 0 {
+			t.Errorf("%d goroutines leaked by the test:\n\n%s", count, strings.Join(stacks, "\n\n"))
+		}
+	})
+}
+
+// leakedGoroutines waits up to the timeout for the goroutines labeled with the
+// test name to return, and returns the number of those still running and their
+// stacks, grouped by the goroutine profile.
+func leakedGoroutines(name string, timeout time.Duration) (int, []string) {
+	deadline := time.Now().Add(timeout)
+	for {
+		count, stacks := labeledGoroutines(name)
+		if count == 0 || time.Now().After(deadline) {
+			return count, stacks
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// labeledGoroutines returns the number of running goroutines labeled with the
+// test name, other than the calling one and those whose top function is
+// ignored, and their stacks.
+func labeledGoroutines(name string) (int, []string) {
+	var profile bytes.Buffer
+	// the first debug level prints the labels of each group of goroutines with
+	// the same stack, which the later ones don't
+	if err := pprof.Lookup("goroutine").WriteTo(&profile, 1); err != nil {
+		return 0, nil
+	}
+	label := fmt.Sprintf("%q:%q", testLabelKey, name)
+	var count int
+	var stacks []string
+	// groups are separated by empty lines, after a "goroutine profile" header,
+	// and start with a "<count> @ <addresses>" line, followed by the labels if
+	// any, then by a line for each function of the stack
+	for _, group := range strings.Split(profile.String(), "\n\n")[1:] {
+		lines := strings.Split(strings.TrimSpace(group), "\n")
+		if len(lines) < 3 || !strings.HasPrefix(lines[1], "# labels: ") || !hasLabel(lines[1], label) {
+			continue
+		}
+		if strings.Contains(group, "runtime/pprof.writeGoroutine") || isIgnoredFunction(lines[2]) {
+			continue
+		}
+		groupCount, err := strconv.Atoi(strings.Fields(lines[0])[0])
+		if err != nil {
+			continue
+		}
+		count += groupCount
+		stacks = append(stacks, group)
+	}
+	return count, stacks
+}
+
+// hasLabel reports whether the labels line of a group of goroutines has the
+// label, followed by the end of the labels or by another label, so that the
+// name of a test doesn't match its subtests.
+func hasLabel(line, label string) bool {
+	return strings.Contains(line, label+"}") || strings.Contains(line, label+",")
+}
+
+// isIgnoredFunction reports whether the function line of the stack of a group
+// of goroutines, formatted as "#\t<pc>\t<function>+<offset>\t<file>:<line>",
+// is an ignored top function.
+func isIgnoredFunction(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return false
+	}
+	for _, prefix := range ignoredTopFunctionPrefixes {
+		if strings.HasPrefix(fields[2], prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+	"github.com/bufbuild/connect-crosstest/internal/crosstesting"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	"github.com/bufbuild/connect-go"
+)
+
+// TestInProcess runs the test cases against an in-process server for each
+// protocol: those shared with the crosstest command, then those needing
+// specially configured clients. The test cases needing more than clients of the
+// test service, such as custom transports or specially configured servers, are
+// only run by the client binary.
+func TestInProcess(t *testing.T) {
+	t.Parallel()
+	protocols := []struct {
+		name    string
+		options []connect.ClientOption
+	}{
+		{name: "connect"},
+		{name: "grpc", options: []connect.ClientOption{connect.WithGRPC()}},
+		{name: "grpc-web", options: []connect.ClientOption{connect.WithGRPCWeb()}},
+	}
+	for _, protocol := range protocols {
+		protocol := protocol
+		t.Run(protocol.name, func(t *testing.T) {
+			t.Parallel()
+			newClient := func(options ...connect.ClientOption) testingconnect.TestServiceClient {
+				return NewInProcessClient(t, connect.WithClientOptions(protocol.options...), connect.WithClientOptions(options...))
+			}
+			client := newClient()
+			compressedClient := newClient(connect.WithSendGzip())
+			zstdClient := newClient(compression.WithAcceptZstd(), connect.WithSendCompression(compression.Zstd))
+			deflateClient := newClient(compression.WithAcceptDeflate(), connect.WithSendCompression(compression.Deflate))
+			brotliClient := newClient(compression.WithAcceptBrotli(), connect.WithSendCompression(compression.Brotli))
+			accepted := []string{compression.Brotli, compression.Zstd}
+			negotiationClient := newClient(compression.WithAccept(accepted...))
+			limitedClient := newClient(connect.WithReadMaxBytes(oneMiB))
+			const maxRetries, backoff = 3, 10 * time.Millisecond
+			retryClient := newClient(connect.WithInterceptors(NewRetryInterceptor(maxRetries, backoff, UnaryCallProcedure)))
+			for _, testCase := range ClientTestCases(0) {
+				testCase := testCase
+				t.Run(testCase.Name, func(t *testing.T) {
+					t.Parallel()
+					testCase.Run(crosstesting.NewTB(t), client)
+				})
+			}
+			// the test cases needing specially configured clients, which the
+			// crosstest command doesn't run
+			testCases := []struct {
+				name string
+				run  func(crosstesting.TB)
+			}{
+				{"client_compressed_unary", func(t crosstesting.TB) { DoClientCompressedUnary(t, client, compressedClient) }},
+				{"unary_with_compressed_empty_message", func(t crosstesting.TB) { DoUnaryWithCompressedEmptyMessage(t, compressedClient) }},
+				{"server_compressed_unary", func(t crosstesting.TB) { DoServerCompressedUnary(t, compressedClient) }},
+				{"zstd_compressed_unary", func(t crosstesting.TB) { DoZstdCompressedUnary(t, zstdClient) }},
+				{"deflate_compressed_unary", func(t crosstesting.TB) { DoDeflateCompressedUnary(t, deflateClient) }},
+				{"brotli_compressed_unary", func(t crosstesting.TB) { DoBrotliCompressedUnary(t, brotliClient) }},
+				{"compression_negotiation", func(t crosstesting.TB) { DoCompressionNegotiation(t, negotiationClient, accepted) }},
+				{"exceeds_message_size_limit", func(t crosstesting.TB) { DoExceedsMessageSizeLimit(t, limitedClient, oneMiB) }},
+				{"client_compressed_streaming", func(t crosstesting.TB) { DoClientCompressedStreaming(t, client, compressedClient) }},
+				{"server_compressed_streaming", func(t crosstesting.TB) { DoServerCompressedStreaming(t, compressedClient) }},
+				{"peer_address", func(t crosstesting.TB) { DoPeerAddress(t, client, nil) }},
+				{"retry_on_unavailable", func(t crosstesting.TB) { DoRetryOnUnavailable(t, retryClient, maxRetries, backoff) }},
+			}
+			for _, testCase := range testCases {
+				testCase := testCase
+				t.Run(testCase.name, func(t *testing.T) {
+					t.Parallel()
+					testCase.run(crosstesting.NewTB(t))
+				})
+			}
+		})
+	}
+}
+
+func TestServerStreamingBackpressureWithCancel(t *testing.T) {
+	// not parallel, since the goroutines of other tests would be counted too
+	DoServerStreamingBackpressureWithCancel(crosstesting.NewTB(t), NewInProcessClient(t))
+}
@@ -0,0 +1,402 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/interop"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewSizeLoggingInterceptor returns an interceptor that logs the sizes of the
+// request and response messages of unary calls.
+func NewSizeLoggingInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			response, err := next(ctx, request)
+			responseSize := 0
+			if response != nil {
+				responseSize = messageSize(response.Any())
+			}
+			log.Printf(
+				"SIZE:  %s request %d bytes, response %d bytes",
+				request.Spec().Procedure,
+				messageSize(request.Any()),
+				responseSize,
+			)
+			return response, err
+		}
+	})
+}
+
+// NewLatencyInterceptor returns an interceptor that delays the responses of
+// unary handlers by the given latency. If the context is done during the delay,
+// the handler returns the error of the context instead.
+func NewLatencyInterceptor(latency time.Duration) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			response, err := next(ctx, request)
+			if sleepErr := interop.Sleep(ctx, latency); sleepErr != nil {
+				return nil, sleepErr
+			}
+			return response, err
+		}
+	})
+}
+
+// NewVersionInterceptor returns a handler interceptor that sets the version
+// header to the given version on the responses of both unary and streaming
+// RPCs. Failed unary RPCs only carry it if they fail with a *connect.Error,
+// whose metadata is sent with the error.
+func NewVersionInterceptor(version string) connect.Interceptor {
+	return &versionInterceptor{version: version}
+}
+
+type versionInterceptor struct {
+	version string
+}
+
+func (i *versionInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient {
+			return next(ctx, request)
+		}
+		response, err := next(ctx, request)
+		if err != nil {
+			if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+				connectErr.Meta().Set(interop.VersionKey, i.version)
+			}
+			return response, err
+		}
+		response.Header().Set(interop.VersionKey, i.version)
+		return response, nil
+	}
+}
+
+func (i *versionInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *versionInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		conn.ResponseHeader().Set(interop.VersionKey, i.version)
+		return next(ctx, conn)
+	}
+}
+
+// NewRPCLoggingInterceptor returns a handler interceptor that logs one line per
+// RPC, with the procedure, the peer, the code, and the number and total size
+// of the messages received and sent. The peer is only known if the handler is
+// wrapped with NewPeerHandler.
+func NewRPCLoggingInterceptor() connect.Interceptor {
+	return &rpcLoggingInterceptor{}
+}
+
+type rpcLoggingInterceptor struct{}
+
+func (i *rpcLoggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient {
+			return next(ctx, request)
+		}
+		start := time.Now()
+		response, err := next(ctx, request)
+		stats := &rpcStats{received: 1, receivedBytes: messageSize(request.Any())}
+		// on errors, the response may be a typed nil
+		if err == nil {
+			stats.sent, stats.sentBytes = 1, messageSize(response.Any())
+		}
+		logRPC(ctx, request.Spec(), stats, err, time.Since(start))
+		return response, err
+	}
+}
+
+func (i *rpcLoggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *rpcLoggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		statsConn := &statsHandlerConn{StreamingHandlerConn: conn}
+		err := next(ctx, statsConn)
+		logRPC(ctx, conn.Spec(), &statsConn.stats, err, time.Since(start))
+		return err
+	}
+}
+
+// rpcStats counts the messages of an RPC, in both directions.
+type rpcStats struct {
+	received      int
+	receivedBytes int
+	sent          int
+	sentBytes     int
+}
+
+// statsHandlerConn counts the messages received and sent on a stream.
+type statsHandlerConn struct {
+	connect.StreamingHandlerConn
+
+	stats rpcStats
+}
+
+func (c *statsHandlerConn) Receive(message any) error {
+	if err := c.StreamingHandlerConn.Receive(message); err != nil {
+		return err
+	}
+	c.stats.received++
+	c.stats.receivedBytes += messageSize(message)
+	return nil
+}
+
+func (c *statsHandlerConn) Send(message any) error {
+	if err := c.StreamingHandlerConn.Send(message); err != nil {
+		return err
+	}
+	c.stats.sent++
+	c.stats.sentBytes += messageSize(message)
+	return nil
+}
+
+func logRPC(ctx context.Context, spec connect.Spec, stats *rpcStats, err error, duration time.Duration) {
+	peer, ok := peerFromContext(ctx)
+	if !ok {
+		peer = "unknown"
+	}
+	log.Printf(
+		"RPC:   procedure=%s peer=%s code=%s received=%d received_bytes=%d sent=%d sent_bytes=%d duration=%v",
+		spec.Procedure,
+		peer,
+		codeName(err),
+		stats.received,
+		stats.receivedBytes,
+		stats.sent,
+		stats.sentBytes,
+		duration,
+	)
+}
+
+// codeName returns the name of the code of the error of an RPC, or "ok" if it
+// succeeded. Handlers may return the error of their context as is, which
+// connect-go only turns into a code when writing it, so it's mapped the same.
+func codeName(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, context.Canceled):
+		return connect.CodeCanceled.String()
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.CodeDeadlineExceeded.String()
+	default:
+		return connect.CodeOf(err).String()
+	}
+}
+
+// NewUserAgentInterceptor returns a client interceptor that overrides the
+// User-Agent header of each request. connect-go writes its default user-agent
+// before running the interceptors, so an interceptor is the only way for a
+// client to set its own.
+func NewUserAgentInterceptor(userAgent string) connect.Interceptor {
+	return &userAgentInterceptor{userAgent: userAgent}
+}
+
+type userAgentInterceptor struct {
+	userAgent string
+}
+
+func (i *userAgentInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient {
+			request.Header().Set("User-Agent", i.userAgent)
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *userAgentInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set("User-Agent", i.userAgent)
+		return conn
+	}
+}
+
+func (i *userAgentInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// NewBearerTokenInterceptor returns a client interceptor that attaches the
+// token to the authorization header of each request.
+func NewBearerTokenInterceptor(token string) connect.Interceptor {
+	return &bearerTokenInterceptor{token: token}
+}
+
+type bearerTokenInterceptor struct {
+	token string
+}
+
+func (i *bearerTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient {
+			request.Header().Set(interop.AuthorizationKey, interop.BearerToken(i.token))
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *bearerTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set(interop.AuthorizationKey, interop.BearerToken(i.token))
+		return conn
+	}
+}
+
+func (i *bearerTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// NewRetryInterceptor returns a client interceptor that retries the unary calls
+// to the given procedures when they fail with CodeUnavailable, up to maxRetries
+// times. It waits for the backoff before the first retry, doubling the wait
+// before each subsequent one. connect-go doesn't know which procedures are
+// idempotent, and so safe to retry, so they're given explicitly. Like gRPC
+// clients, it sets the number of previous attempts on each retry.
+func NewRetryInterceptor(maxRetries int, backoff time.Duration, idempotentProcedures ...string) connect.Interceptor {
+	procedures := make(map[string]struct{}, len(idempotentProcedures))
+	for _, procedure := range idempotentProcedures {
+		procedures[procedure] = struct{}{}
+	}
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			if _, ok := procedures[request.Spec().Procedure]; !ok || !request.Spec().IsClient {
+				return next(ctx, request)
+			}
+			wait := backoff
+			for attempt := 0; ; attempt++ {
+				if attempt > 0 {
+					request.Header().Set(interop.PreviousAttemptsKey, strconv.Itoa(attempt))
+				}
+				response, err := next(ctx, request)
+				if err == nil || attempt == maxRetries || connect.CodeOf(err) != connect.CodeUnavailable {
+					return response, err
+				}
+				// stop retrying if the context is done during the wait, returning the
+				// error of the last attempt
+				if sleepErr := interop.Sleep(ctx, wait); sleepErr != nil {
+					return response, err
+				}
+				wait *= 2
+			}
+		}
+	})
+}
+
+// NewTimeoutInterceptor returns a client interceptor that gives the calls
+// without a deadline the given timeout, so that a call to a hung server fails
+// with CodeDeadlineExceeded, and with it only the test case making it. The
+// calls with a deadline keep it, since test cases set them on purpose.
+func NewTimeoutInterceptor(timeout time.Duration) connect.Interceptor {
+	return &timeoutInterceptor{timeout: timeout}
+}
+
+type timeoutInterceptor struct {
+	timeout time.Duration
+}
+
+func (i *timeoutInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if _, ok := ctx.Deadline(); ok || !request.Spec().IsClient {
+			return next(ctx, request)
+		}
+		ctx, cancel := context.WithTimeout(ctx, i.timeout)
+		defer cancel()
+		return next(ctx, request)
+	}
+}
+
+func (i *timeoutInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		if _, ok := ctx.Deadline(); ok {
+			return next(ctx, spec)
+		}
+		ctx, cancel := context.WithTimeout(ctx, i.timeout)
+		return &timeoutClientConn{StreamingClientConn: next(ctx, spec), cancel: cancel}
+	}
+}
+
+func (i *timeoutInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// timeoutClientConn releases the timeout of the stream once it's closed.
+type timeoutClientConn struct {
+	connect.StreamingClientConn
+
+	cancel context.CancelFunc
+}
+
+func (c *timeoutClientConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
+	c.cancel()
+	return err
+}
+
+// NewAuthInterceptor returns a handler interceptor that rejects requests
+// without the bearer token with the status UNAUTHENTICATED.
+func NewAuthInterceptor(token string) connect.Interceptor {
+	return &authInterceptor{token: token}
+}
+
+type authInterceptor struct {
+	token string
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if !request.Spec().IsClient {
+			if err := interop.CheckBearerToken(request.Header().Get(interop.AuthorizationKey), i.token); err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := interop.CheckBearerToken(conn.RequestHeader().Get(interop.AuthorizationKey), i.token); err != nil {
+			return connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return next(ctx, conn)
+	}
+}
+
+func messageSize(message any) int {
+	if message, ok := message.(proto.Message); ok {
+		return proto.Size(message)
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	t.Parallel()
+	checkLeakedGoroutines(t)
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(NewTestServiceHandler(0)))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	const timeout = 100 * time.Millisecond
+	client := testingconnect.NewTestServiceClient(
+		server.Client(),
+		server.URL,
+		connect.WithInterceptors(NewTimeoutInterceptor(timeout)),
+	)
+	t.Run("unary", func(t *testing.T) {
+		t.Parallel()
+		request := connect.NewRequest(&testpb.SimpleRequest{})
+		request.Header().Set(interop.UnarySleepKey, time.Minute.String())
+		start := time.Now()
+		_, err := client.UnaryCall(context.Background(), request)
+		assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err), "unexpected error: %v", err)
+		assert.Less(t, time.Since(start), 10*timeout)
+	})
+	t.Run("streaming", func(t *testing.T) {
+		t.Parallel()
+		start := time.Now()
+		stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(&testpb.StreamingOutputCallRequest{
+			ResponseParameters: []*testpb.ResponseParameters{
+				// the handler would wait for a minute without the timeout
+				{Size: 1, IntervalUs: int32(time.Minute.Microseconds())},
+			},
+		}))
+		require.NoError(t, err)
+		assert.False(t, stream.Receive())
+		assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(stream.Err()), "unexpected error: %v", stream.Err())
+		assert.NoError(t, stream.Close())
+		assert.Less(t, time.Since(start), 10*timeout)
+	})
+	t.Run("deadline", func(t *testing.T) {
+		t.Parallel()
+		// the deadline set by the caller is kept, even if longer than the timeout
+		ctx, cancel := context.WithTimeout(context.Background(), 10*timeout)
+		defer cancel()
+		request := connect.NewRequest(&testpb.SimpleRequest{})
+		request.Header().Set(interop.UnarySleepKey, (2 * timeout).String())
+		_, err := client.UnaryCall(ctx, request)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// withEchoMetadata adds the initial metadata the test server echoes in the
+// response headers, and the binary trailing metadata it echoes in the response
+// trailers, to the request headers.
+func withEchoMetadata(header http.Header, initial []string, trailing [][]byte) {
+	for _, value := range initial {
+		header.Add(leadingMetadataKey, value)
+	}
+	for _, value := range trailing {
+		header.Add(trailingMetadataKey, connect.EncodeBinaryHeader(value))
+	}
+}
+
+// echoMetadataFrom returns the initial and trailing metadata added to the
+// request headers by withEchoMetadata.
+func echoMetadataFrom(header http.Header) (initial []string, trailing [][]byte, err error) {
+	trailing, err = decodeBinaryValues(header.Values(trailingMetadataKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	return header.Values(leadingMetadataKey), trailing, nil
+}
+
+// echoMetadata echoes the metadata in the request headers to the response
+// headers and trailers.
+func echoMetadata(requestHeader, responseHeader, responseTrailer http.Header) error {
+	initial, trailing, err := echoMetadataFrom(requestHeader)
+	if err != nil {
+		return err
+	}
+	for _, value := range initial {
+		responseHeader.Add(leadingMetadataKey, value)
+	}
+	for _, value := range trailing {
+		responseTrailer.Add(trailingMetadataKey, connect.EncodeBinaryHeader(value))
+	}
+	return nil
+}
+
+func decodeBinaryValues(values []string) ([][]byte, error) {
+	decoded := make([][]byte, 0, len(values))
+	for _, value := range values {
+		bytes, err := connect.DecodeBinaryHeader(value)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, bytes)
+	}
+	return decoded, nil
+}
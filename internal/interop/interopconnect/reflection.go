@@ -0,0 +1,117 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serverReflectionServiceName = "grpc.reflection.v1alpha.ServerReflection"
+	serverReflectionInfoPath    = "/" + serverReflectionServiceName + "/ServerReflectionInfo"
+)
+
+// NewServerReflectionHandler returns the path and handler of the gRPC server
+// reflection service (v1alpha), listing the given services along with itself.
+// The descriptors are resolved from the global registry. The reflection
+// service is implemented by grpc-go, and is served by connect-go as a regular
+// bidi streaming RPC.
+func NewServerReflectionHandler(services []string, options ...connect.HandlerOption) (string, http.Handler) {
+	names := append(serviceNames{serverReflectionServiceName}, services...)
+	server := reflection.NewServer(reflection.ServerOptions{Services: names})
+	mux := http.NewServeMux()
+	mux.Handle(serverReflectionInfoPath, connect.NewBidiStreamHandler(
+		serverReflectionInfoPath,
+		func(ctx context.Context, stream *connect.BidiStream[reflectionpb.ServerReflectionRequest, reflectionpb.ServerReflectionResponse]) error {
+			err := server.ServerReflectionInfo(&serverReflectionStream{ctx: ctx, stream: stream})
+			if grpcStatus, ok := status.FromError(err); ok && err != nil {
+				return connect.NewError(connect.Code(grpcStatus.Code()), errors.New(grpcStatus.Message()))
+			}
+			return err
+		},
+		options...,
+	))
+	return "/" + serverReflectionServiceName + "/", mux
+}
+
+// ServerReflectionClient is a client for the gRPC server reflection service
+// (v1alpha).
+type ServerReflectionClient struct {
+	serverReflectionInfo *connect.Client[reflectionpb.ServerReflectionRequest, reflectionpb.ServerReflectionResponse]
+}
+
+// NewServerReflectionClient constructs a client for the gRPC server reflection
+// service (v1alpha).
+func NewServerReflectionClient(httpClient connect.HTTPClient, baseURL string, options ...connect.ClientOption) *ServerReflectionClient {
+	return &ServerReflectionClient{
+		serverReflectionInfo: connect.NewClient[reflectionpb.ServerReflectionRequest, reflectionpb.ServerReflectionResponse](
+			httpClient,
+			baseURL+serverReflectionInfoPath,
+			options...,
+		),
+	}
+}
+
+// ServerReflectionInfo calls grpc.reflection.v1alpha.ServerReflection.ServerReflectionInfo.
+func (c *ServerReflectionClient) ServerReflectionInfo(ctx context.Context) *connect.BidiStreamForClient[reflectionpb.ServerReflectionRequest, reflectionpb.ServerReflectionResponse] {
+	return c.serverReflectionInfo.CallBidiStream(ctx)
+}
+
+// serviceNames implements reflection.ServiceInfoProvider, which only uses the
+// names of the services.
+type serviceNames []string
+
+func (n serviceNames) GetServiceInfo() map[string]grpc.ServiceInfo {
+	info := make(map[string]grpc.ServiceInfo, len(n))
+	for _, name := range n {
+		info[name] = grpc.ServiceInfo{}
+	}
+	return info
+}
+
+// serverReflectionStream adapts a connect-go stream to the grpc-go stream
+// expected by the reflection server, which only sends and receives messages.
+type serverReflectionStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	stream *connect.BidiStream[reflectionpb.ServerReflectionRequest, reflectionpb.ServerReflectionResponse]
+}
+
+func (s *serverReflectionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverReflectionStream) Send(response *reflectionpb.ServerReflectionResponse) error {
+	return s.stream.Send(response)
+}
+
+func (s *serverReflectionStream) Recv() (*reflectionpb.ServerReflectionRequest, error) {
+	request, err := s.stream.Receive()
+	if errors.Is(err, io.EOF) {
+		// the reflection server compares the error to io.EOF to end the stream
+		return nil, io.EOF
+	}
+	return request, err
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// ServerStats counts the RPCs handled by a server by procedure and code, and
+// the streams in flight by procedure. It's safe for concurrent use.
+type ServerStats struct {
+	mu            sync.Mutex
+	rpcs          map[string]map[string]int
+	activeStreams map[string]int
+}
+
+// NewServerStats returns empty server stats, to be maintained by the
+// interceptor returned by NewStatsInterceptor and served by the handler
+// returned by NewStatsHandler.
+func NewServerStats() *ServerStats {
+	return &ServerStats{
+		rpcs:          make(map[string]map[string]int),
+		activeStreams: make(map[string]int),
+	}
+}
+
+// serverStatsJSON is the JSON representation of ServerStats. The RPCs are
+// counted by procedure then by code, with "ok" for the successful ones.
+type serverStatsJSON struct {
+	RPCs          map[string]map[string]int `json:"rpcs"`
+	ActiveStreams map[string]int            `json:"active_streams"`
+}
+
+func (s *ServerStats) finish(procedure string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	codes, ok := s.rpcs[procedure]
+	if !ok {
+		codes = make(map[string]int)
+		s.rpcs[procedure] = codes
+	}
+	codes[codeName(err)]++
+}
+
+func (s *ServerStats) addActiveStream(procedure string, delta int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activeStreams[procedure] += delta
+}
+
+func (s *ServerStats) snapshot() *serverStatsJSON {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := &serverStatsJSON{
+		RPCs:          make(map[string]map[string]int, len(s.rpcs)),
+		ActiveStreams: make(map[string]int, len(s.activeStreams)),
+	}
+	for procedure, codes := range s.rpcs {
+		snapshot.RPCs[procedure] = make(map[string]int, len(codes))
+		for code, count := range codes {
+			snapshot.RPCs[procedure][code] = count
+		}
+	}
+	for procedure, count := range s.activeStreams {
+		snapshot.ActiveStreams[procedure] = count
+	}
+	return snapshot
+}
+
+// NewStatsInterceptor returns a handler interceptor that counts the RPCs in
+// the stats once they complete, and the streaming RPCs as active streams while
+// they run.
+func NewStatsInterceptor(stats *ServerStats) connect.Interceptor {
+	return &statsInterceptor{stats: stats}
+}
+
+type statsInterceptor struct {
+	stats *ServerStats
+}
+
+func (i *statsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient {
+			return next(ctx, request)
+		}
+		response, err := next(ctx, request)
+		i.stats.finish(request.Spec().Procedure, err)
+		return response, err
+	}
+}
+
+func (i *statsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *statsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		i.stats.addActiveStream(procedure, 1)
+		defer i.stats.addActiveStream(procedure, -1)
+		err := next(ctx, conn)
+		i.stats.finish(procedure, err)
+		return err
+	}
+}
+
+// NewStatsHandler returns a handler serving the stats as JSON, so that clients
+// can check which RPCs the server observed.
+func NewStatsHandler(stats *ServerStats) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			writer.Header().Set("Allow", http.MethodGet)
+			http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(writer).Encode(stats.snapshot()); err != nil {
+			log.Printf("failed to write stats: %v", err)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const streamingOutputCallProcedure = "/grpc.testing.TestService/StreamingOutputCall"
+
+func TestStatsInterceptor(t *testing.T) {
+	t.Parallel()
+	checkLeakedGoroutines(t)
+	stats := NewServerStats()
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(
+		NewTestServiceHandler(0),
+		connect.WithInterceptors(NewStatsInterceptor(stats)),
+	))
+	mux.Handle("/debug/stats", NewStatsHandler(stats))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := testingconnect.NewTestServiceClient(server.Client(), server.URL)
+	fetchStats := func() (*serverStatsJSON, error) {
+		response, err := server.Client().Get(server.URL + "/debug/stats")
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status %d", response.StatusCode)
+		}
+		if contentType := response.Header.Get("Content-Type"); contentType != "application/json" {
+			return nil, fmt.Errorf("unexpected content type %q", contentType)
+		}
+		var snapshot serverStatsJSON
+		if err := json.NewDecoder(response.Body).Decode(&snapshot); err != nil {
+			return nil, err
+		}
+		return &snapshot, nil
+	}
+	getStats := func() *serverStatsJSON {
+		snapshot, err := fetchStats()
+		require.NoError(t, err)
+		return snapshot
+	}
+
+	_, err := client.UnaryCall(context.Background(), connect.NewRequest(&testpb.SimpleRequest{}))
+	require.NoError(t, err)
+	_, err = client.UnaryCall(context.Background(), connect.NewRequest(&testpb.SimpleRequest{
+		ResponseStatus: &testpb.EchoStatus{Code: int32(connect.CodeNotFound)},
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "unexpected error: %v", err)
+	assert.Equal(t, map[string]int{"ok": 1, "not_found": 1}, getStats().RPCs[UnaryCallProcedure])
+
+	// the stream waits for a minute before its second response, so it's active
+	// until it's canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{
+			{Size: 1},
+			{Size: 1, IntervalUs: int32(time.Minute.Microseconds())},
+		},
+	}))
+	require.NoError(t, err)
+	require.True(t, stream.Receive(), "unexpected error: %v", stream.Err())
+	snapshot := getStats()
+	assert.Equal(t, 1, snapshot.ActiveStreams[streamingOutputCallProcedure])
+	assert.Empty(t, snapshot.RPCs[streamingOutputCallProcedure])
+	cancel()
+	assert.False(t, stream.Receive())
+	_ = stream.Close()
+	// the handler completes after the client sees the cancellation
+	assert.Eventually(t, func() bool {
+		snapshot, err := fetchStats()
+		return err == nil &&
+			snapshot.ActiveStreams[streamingOutputCallProcedure] == 0 &&
+			snapshot.RPCs[streamingOutputCallProcedure]["canceled"] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	response, err := server.Client().Post(server.URL+"/debug/stats", "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	assert.Equal(t, http.MethodGet, response.Header.Get("Allow"))
+}
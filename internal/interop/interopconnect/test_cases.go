@@ -15,22 +15,39 @@
 package interopconnect
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/bufbuild/connect-crosstest/internal/compression"
 	"github.com/bufbuild/connect-crosstest/internal/crosstesting"
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/health/v1/healthv1connect"
 	connectpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	healthv1 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/health/v1"
 	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	orcav3 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/xds/data/orca/v3"
 	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/bufbuild/connect-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -38,19 +55,29 @@ const (
 	sixteenBytes        = 16
 	oneKiB              = 1024
 	twoKiB              = 2028
+	eightKiB            = 8192
+	sixteenKiB          = 16384
 	thirtyTwoKiB        = 32768
 	sixtyFourKiB        = 65536
 	twoFiftyKiB         = 256000
 	fiveHundredKiB      = 512000
+	oneMiB              = 1048576
 	largeReqSize        = twoFiftyKiB
 	largeRespSize       = fiveHundredKiB
 	leadingMetadataKey  = "x-grpc-test-echo-initial"
 	trailingMetadataKey = "x-grpc-test-echo-trailing-bin"
+	echoDeadlineKey     = "x-grpc-test-echo-deadline"
+	echoUserAgentKey    = "x-grpc-test-echo-user-agent"
+	echoRequestSizeKey  = "x-grpc-test-echo-request-size"
+	echoPeerKey         = "x-grpc-test-echo-peer"
 )
 
 var (
 	reqSizes  = []int{twoFiftyKiB, eightBytes, oneKiB, thirtyTwoKiB}      // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
 	respSizes = []int{fiveHundredKiB, sixteenBytes, twoKiB, sixtyFourKiB} // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
+	// sizes of the metadata values of the oversized metadata test, to find
+	// where the header size limits of the implementations kick in
+	oversizedMetadataSizes = []int{eightKiB, sixteenKiB, sixtyFourKiB} // nolint:gochecknoglobals
 )
 
 // clientNewPayload returns a payload of the given type and size.
@@ -78,6 +105,45 @@ func DoEmptyUnaryCall(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful unary call")
 }
 
+// DoEmptyUnaryCallWithHeaders performs an empty unary RPC with metadata to
+// echo, and checks that the metadata is echoed back even though the response
+// message is empty.
+func DoEmptyUnaryCallWithHeaders(t crosstesting.TB, client connectpb.TestServiceClient) {
+	request := connect.NewRequest(&testpb.Empty{})
+	withEchoMetadata(request.Header(), []string{leadingMetadataValue}, [][]byte{[]byte(trailingMetadataValue)})
+	reply, err := client.EmptyCall(context.Background(), request)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Empty{}, reply.Msg))
+	validateMetadata(t, reply.Header(), reply.Trailer(), []string{leadingMetadataValue}, [][]byte{[]byte(trailingMetadataValue)})
+	t.Successf("successful empty unary call with headers")
+}
+
+// DoServerVersion checks that the server stamps the responses of both unary
+// and streaming calls with its version, to identify the server build a run
+// hit.
+func DoServerVersion(t crosstesting.TB, client connectpb.TestServiceClient) {
+	reply, err := client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	version := reply.Header().Get(interop.VersionKey)
+	assert.NotEmpty(t, version, "missing %s header on unary response", interop.VersionKey)
+	stream, err := client.StreamingOutputCall(
+		context.Background(),
+		connect.NewRequest(&testpb.StreamingOutputCallRequest{
+			ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+		}),
+	)
+	require.NoError(t, err)
+	var received int
+	for stream.Receive() {
+		received++
+	}
+	require.NoError(t, stream.Err())
+	assert.Equal(t, 1, received)
+	assert.NotEmpty(t, stream.ResponseHeader().Get(interop.VersionKey), "missing %s header on streaming response", interop.VersionKey)
+	require.NoError(t, stream.Close())
+	t.Successf("successful server version %s", version)
+}
+
 // DoLargeUnaryCall performs a unary RPC with large payload in the request and response.
 func DoLargeUnaryCall(t crosstesting.TB, client connectpb.TestServiceClient) {
 	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
@@ -94,6 +160,374 @@ func DoLargeUnaryCall(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful large unary call")
 }
 
+// DoLargeUnaryCallBidirectionalSizes performs a unary RPC with a large
+// request and a large response, so that the request is read and the response
+// is written with large frames in the same call. The client asks the server to
+// echo the size of the request payload it received.
+func DoLargeUnaryCallBidirectionalSizes(t crosstesting.TB, client connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, oneMiB)
+	require.NoError(t, err)
+	request := connect.NewRequest(&testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(oneMiB),
+		Payload:      pl,
+	})
+	request.Header().Set(echoRequestSizeKey, "true")
+	reply, err := client.UnaryCall(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), oneMiB)
+	assert.Equal(t, strconv.Itoa(oneMiB), reply.Header().Get(echoRequestSizeKey))
+	t.Successf("successful large unary call with bidirectional sizes")
+}
+
+// DoBoundarySizeUnary performs unary RPCs with request and response payloads
+// of sizes around the boundaries of the length-prefixed envelopes and of the
+// buffers used to read and write them, to catch off-by-one framing errors.
+func DoBoundarySizeUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, size := range []int{0, 1, 4, 5, 16383, 16384, 16385, 1 << 20} {
+		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, size)
+		require.NoError(t, err)
+		req := &testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(size),
+			Payload:      pl,
+		}
+		reply, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err, "payload of %d bytes", size)
+		assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+		assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), size)
+	}
+	t.Successf("successful boundary size unary")
+}
+
+// DoClientCompressedUnary performs a unary RPC with a compressed request. The
+// server is first probed with an uncompressed request that it expects to be
+// compressed, which it must reject with an invalid argument error.
+func DoClientCompressedUnary(t crosstesting.TB, client, compressedClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		Payload:      pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	// Probe the server with an uncompressed request.
+	_, err = client.UnaryCall(context.Background(), connect.NewRequest(req))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeInvalidArgument)
+	// Send a compressed request that the server expects to be compressed.
+	reply, err := compressedClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	// Send an uncompressed request that the server expects to be uncompressed.
+	req.ExpectCompressed = &testpb.BoolValue{
+		Value: false,
+	}
+	reply, err = client.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful client compressed unary")
+}
+
+// DoUnaryWithCompressedEmptyMessage performs unary RPCs with a request that
+// marshals to zero bytes, with a client compressing its requests. connect-go
+// compresses empty messages too, so the server must handle a compressed frame
+// carrying no data. UnaryCall must respond with an empty payload, and
+// EmptyCall with an empty message.
+func DoUnaryWithCompressedEmptyMessage(t crosstesting.TB, compressedClient connectpb.TestServiceClient) {
+	req := &testpb.SimpleRequest{}
+	require.Zero(t, proto.Size(req))
+	reply, err := compressedClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Empty(t, reply.Msg.GetPayload().GetBody())
+	emptyReply, err := compressedClient.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Empty{}, emptyReply.Msg))
+	t.Successf("successful unary with compressed empty message")
+}
+
+// textWords are the words of the text payloads of the gzip level test.
+var textWords = strings.Fields( // nolint:gochecknoglobals
+	"the quick brown fox jumps over the lazy dog while connect and grpc stream unary messages",
+)
+
+// newTextPayload returns a payload of the given size made of words picked
+// pseudo-randomly, like text, which compresses to sizes that differ more
+// between gzip levels than the zeros of other payloads.
+func newTextPayload(size int) *testpb.Payload {
+	random := interop.NewRand(1)
+	body := make([]byte, 0, size+oneKiB)
+	for len(body) < size {
+		body = append(body, textWords[random.Intn(len(textWords))]...)
+		body = append(body, ' ')
+	}
+	return &testpb.Payload{
+		Type: testpb.PayloadType_COMPRESSABLE,
+		Body: body[:size],
+	}
+}
+
+// DoGzipCompressionLevels performs the same unary RPC with a highly compressible
+// request with a client compressing with gzip at the best speed, then with one
+// compressing at the best compression. Both clients use the transport, which
+// counts the bytes of the compressed requests, and it must see a smaller
+// request for the best compression.
+func DoGzipCompressionLevels(
+	t crosstesting.TB,
+	bestSpeedClient, bestCompressionClient connectpb.TestServiceClient,
+	transport *RequestBytesCountingTransport,
+) {
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+		Payload:      newTextPayload(largeReqSize),
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	requestBytes := func(client connectpb.TestServiceClient) int64 {
+		before := transport.RequestBytes()
+		_, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+		return transport.RequestBytes() - before
+	}
+	bestSpeedBytes := requestBytes(bestSpeedClient)
+	bestCompressionBytes := requestBytes(bestCompressionClient)
+	assert.Less(t, bestSpeedBytes, int64(largeReqSize))
+	assert.Less(t, bestCompressionBytes, bestSpeedBytes)
+	t.Successf("successful gzip compression levels, %d bytes at best speed and %d at best compression", bestSpeedBytes, bestCompressionBytes)
+}
+
+// DoUnaryWithRequestCompressionMismatch performs a unary RPC with a client
+// whose transport claims that the uncompressed request is gzip compressed. The
+// server must fail to decompress it, with an internal or invalid argument
+// error, as grpc-go servers return an internal error.
+func DoUnaryWithRequestCompressionMismatch(t crosstesting.TB, mismatchClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, oneKiB)
+	require.NoError(t, err)
+	_, err = mismatchClient.UnaryCall(
+		context.Background(),
+		connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(oneKiB),
+			Payload:      pl,
+		}),
+	)
+	require.Error(t, err)
+	assert.Contains(
+		t,
+		[]connect.Code{connect.CodeInternal, connect.CodeInvalidArgument},
+		connect.CodeOf(err),
+		"unexpected error: %v",
+		err,
+	)
+	t.Successf("successful unary with request compression mismatch")
+}
+
+// DoServerCompressedUnary performs a unary RPC requesting a compressed
+// response. Responses requesting no compression aren't tested, since neither
+// test server can opt a single response out of compression: connect-go and
+// grpc-go negotiate it once per call from the compression used or accepted by
+// the client.
+func DoServerCompressedUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		ResponseCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	reply, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, responseCompression(reply.Header()), "gzip")
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful server compressed unary")
+}
+
+// responseCompression returns the compression used for the response messages,
+// based on the encoding header of the protocol used by the client.
+func responseCompression(header http.Header) string {
+	for _, key := range []string{"Grpc-Encoding", "Content-Encoding", "Connect-Content-Encoding"} {
+		if encoding := header.Get(key); encoding != "" {
+			return encoding
+		}
+	}
+	return "identity"
+}
+
+// DoZstdCompressedUnary performs a large unary RPC with a client that sends
+// zstd compressed requests. Both the request and the response must be zstd
+// compressed.
+func DoZstdCompressedUnary(t crosstesting.TB, zstdClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		Payload:      pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	reply, err := zstdClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, responseCompression(reply.Header()), compression.Zstd)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful zstd compressed unary")
+}
+
+// DoDeflateCompressedUnary performs a large unary RPC with a client that sends
+// deflate compressed requests. Both the request and the response must be
+// deflate compressed.
+func DoDeflateCompressedUnary(t crosstesting.TB, deflateClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		Payload:      pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	reply, err := deflateClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, responseCompression(reply.Header()), compression.Deflate)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful deflate compressed unary")
+}
+
+// DoBrotliCompressedUnary performs a large unary RPC with a client that sends
+// brotli compressed requests. Both the request and the response must be
+// brotli compressed.
+func DoBrotliCompressedUnary(t crosstesting.TB, brotliClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		Payload:      pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	reply, err := brotliClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, responseCompression(reply.Header()), compression.Brotli)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful brotli compressed unary")
+}
+
+// DoCompressionNegotiation performs a large unary RPC with a client that
+// accepts the given compression algorithms, in addition to gzip. The server
+// must compress the response with a mutually supported algorithm, or fall back
+// to identity when there's none, and the response must decode.
+func DoCompressionNegotiation(t crosstesting.TB, client connectpb.TestServiceClient, accepted []string) {
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+	}
+	reply, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	encoding := responseCompression(reply.Header())
+	acceptable := append([]string{"identity", compression.Gzip}, accepted...)
+	assert.Contains(t, acceptable, encoding)
+	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), largeRespSize)
+	t.Successf("successful compression negotiation with %s", encoding)
+}
+
+// DoPickFirstUnary performs sequential unary RPCs with a client using the
+// given transport, and asserts that all of them are sent on a single
+// connection.
+func DoPickFirstUnary(t crosstesting.TB, client connectpb.TestServiceClient, transport *ConnCountingTransport) {
+	const rpcCount = 100
+	conns := transport.Conns()
+	for i := 0; i < rpcCount; i++ {
+		_, err := client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+		require.NoError(t, err)
+	}
+	// The first RPC may either dial a new connection or reuse an idle one.
+	assert.LessOrEqual(t, transport.Conns()-conns, int64(1))
+	t.Successf("successful pick first unary")
+}
+
+// DoMaxConnectionAge performs unary RPCs continuously from a few goroutines for
+// three times the maximum connection age of the server, which closes the
+// connections as they get older with a GOAWAY. None of the RPCs may fail, since
+// the client must move to a new connection transparently, and at least two new
+// connections must have been used.
+func DoMaxConnectionAge(t crosstesting.TB, client connectpb.TestServiceClient, transport *ConnCountingTransport, maxAge time.Duration) {
+	const workers = 4
+	conns := transport.Conns()
+	deadline := time.Now().Add(3 * maxAge)
+	var calls, failures int64
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				atomic.AddInt64(&calls, 1)
+				if _, err := client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{})); err != nil {
+					if atomic.AddInt64(&failures, 1) == 1 {
+						t.Errorf("first failed call: %v", err)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Zero(t, failures, "%d of %d calls failed", failures, calls)
+	assert.GreaterOrEqual(t, transport.Conns()-conns, int64(2), "connections weren't recycled")
+	t.Successf("successful max connection age")
+}
+
+// DoExceedsMessageSizeLimit performs a unary RPC with a client limited to
+// reading readMaxBytes, requesting a response larger than the limit. connect-go
+// reports the oversized message with an invalid argument error, whereas grpc-go
+// reports RESOURCE_EXHAUSTED.
+func DoExceedsMessageSizeLimit(t crosstesting.TB, limitedClient connectpb.TestServiceClient, readMaxBytes int) {
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(readMaxBytes + 1),
+	}
+	reply, err := limitedClient.UnaryCall(context.Background(), connect.NewRequest(req))
+	assert.Nil(t, reply)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeInvalidArgument)
+	t.Successf("successful exceeds message size limit: %v", err)
+}
+
+// DoExceedsServerMessageSizeLimit performs a unary RPC with a request larger
+// than the maximum message size read by the test servers. connect-go servers
+// reject it with an invalid argument error, and grpc-go servers with
+// RESOURCE_EXHAUSTED.
+func DoExceedsServerMessageSizeLimit(t crosstesting.TB, client connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, interop.ServerReadMaxBytes+1)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(oneKiB),
+		Payload:      pl,
+	}
+	reply, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+	assert.Nil(t, reply)
+	assert.Error(t, err)
+	assert.Contains(t, []connect.Code{connect.CodeInvalidArgument, connect.CodeResourceExhausted}, connect.CodeOf(err))
+	t.Successf("successful exceeds server message size limit: %v", err)
+}
+
 // DoClientStreaming performs a client streaming RPC.
 func DoClientStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
 	stream := client.StreamingInputCall(context.Background())
@@ -113,12 +547,168 @@ func DoClientStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful client streaming test")
 }
 
-// DoServerStreaming performs a server streaming RPC.
-func DoServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+const (
+	largeAggregateMessages    = 1000
+	largeAggregateMessageSize = 1024
+	largeAggregateTimeout     = 10 * time.Second
+)
+
+// DoStreamingInputCallLargeAggregate sends 1000 requests of 1KiB on a client
+// stream, and checks that the server aggregates all of them. The deadline of
+// the call is generous for a local server but fails a stalled stream.
+func DoStreamingInputCallLargeAggregate(t crosstesting.TB, client connectpb.TestServiceClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), largeAggregateTimeout)
+	defer cancel()
+	start := time.Now()
+	stream := client.StreamingInputCall(ctx)
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeAggregateMessageSize)
+	require.NoError(t, err)
+	for i := 0; i < largeAggregateMessages; i++ {
+		require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}), "failed to send request %d", i)
+	}
+	reply, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+	elapsed := time.Since(start)
+	assert.Equal(t, int32(largeAggregateMessages*largeAggregateMessageSize), reply.Msg.GetAggregatedPayloadSize())
+	t.Successf("successful streaming input call large aggregate in %v", elapsed)
+}
+
+// DoGracefulStreamHalfClose performs a client streaming RPC, half-closes the
+// stream with CloseAndReceive, and then checks that sending on the closed
+// stream errors rather than panics.
+func DoGracefulStreamHalfClose(t crosstesting.TB, client connectpb.TestServiceClient) {
+	stream := client.StreamingInputCall(context.Background())
+	var sum int
+	for _, size := range reqSizes {
+		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, size)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}))
+		sum += size
+	}
+	reply, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+	assert.Equal(t, int32(sum), reply.Msg.GetAggregatedPayloadSize())
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, reqSizes[0])
+	require.NoError(t, err)
+	assert.NotPanics(t, func() {
+		err = stream.Send(&testpb.StreamingInputCallRequest{Payload: pl})
+	})
+	assert.Error(t, err, "send after CloseAndReceive")
+	t.Successf("successful graceful stream half close")
+}
+
+// DoClientStreamingWithServerError performs a client streaming RPC whose
+// first request asks the server to fail the call right away, and keeps
+// sending requests. The sends must start failing once the call has failed,
+// well before the client is done sending, without hanging, and the client
+// must receive the server's error from CloseAndReceive.
+func DoClientStreamingWithServerError(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const maxSends = 1000
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := client.StreamingInputCall(ctx)
+	require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{
+		Payload: &testpb.Payload{
+			Type: testpb.PayloadType_COMPRESSABLE,
+			Body: []byte(interop.AbortClientStreamBody),
+		},
+	}))
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, thirtyTwoKiB)
+	require.NoError(t, err)
+	var sends int
+	for sends < maxSends && stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}) == nil {
+		sends++
+	}
+	assert.Less(t, sends, maxSends, "sends kept succeeding after the server failed the call")
+	_, err = stream.CloseAndReceive()
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, interop.AbortClientStreamErrMsg, connectErr.Message())
+	t.Successf("successful client streaming with server error after %d sends", sends)
+}
+
+// DoStreamingInputCallEmptyPayload performs client streaming RPCs where
+// requests with a nil or empty payload are interspersed with real payloads, and
+// where many empty requests are followed by a single payload. The aggregated
+// payload size must only count the bodies of the real payloads.
+func DoStreamingInputCallEmptyPayload(t crosstesting.TB, client connectpb.TestServiceClient) {
+	var interspersed []*testpb.Payload
+	for _, size := range reqSizes {
+		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, size)
+		require.NoError(t, err)
+		interspersed = append(interspersed, nil, pl, &testpb.Payload{})
+	}
+	trailing := make([]*testpb.Payload, 100, 101)
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, oneKiB)
+	require.NoError(t, err)
+	trailing = append(trailing, pl)
+	for _, payloads := range [][]*testpb.Payload{interspersed, trailing} {
+		stream := client.StreamingInputCall(context.Background())
+		var sum int
+		for _, payload := range payloads {
+			require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{
+				Payload: payload,
+			}))
+			sum += len(payload.GetBody())
+		}
+		reply, err := stream.CloseAndReceive()
+		require.NoError(t, err)
+		assert.Equal(t, int32(sum), reply.Msg.GetAggregatedPayloadSize())
+	}
+	t.Successf("successful client streaming with empty payloads")
+}
+
+// DoClientCompressedStreaming performs a client streaming RPC with compressed
+// requests. The server is first probed with an uncompressed stream whose first
+// message is expected to be compressed, which it must reject with an invalid
+// argument error. connect-go compresses all messages of a stream, so the probe
+// and the compressed messages are sent on separate streams.
+func DoClientCompressedStreaming(t crosstesting.TB, client, compressedClient connectpb.TestServiceClient) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 27182)
+	require.NoError(t, err)
+	req := &testpb.StreamingInputCallRequest{
+		Payload: pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: true,
+		},
+	}
+	// Probe the server with an uncompressed stream.
+	stream := client.StreamingInputCall(context.Background())
+	if err := stream.Send(req); err != nil {
+		// The server may have already rejected the stream.
+		assert.True(t, errors.Is(err, io.EOF))
+	}
+	_, err = stream.CloseAndReceive()
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeInvalidArgument)
+	// Send a compressed stream with a message expected to be compressed and a
+	// message that isn't.
+	stream = compressedClient.StreamingInputCall(context.Background())
+	require.NoError(t, stream.Send(req))
+	pl, err = clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 45904)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{
+		Payload: pl,
+		ExpectCompressed: &testpb.BoolValue{
+			Value: false,
+		},
+	}))
+	reply, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+	assert.Equal(t, reply.Msg.GetAggregatedPayloadSize(), int32(27182+45904))
+	t.Successf("successful client compressed streaming")
+}
+
+// DoServerStreaming performs a server streaming RPC, asking the server to wait
+// for the message delay before sending each response.
+func DoServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient, messageDelay time.Duration) {
 	respParam := make([]*testpb.ResponseParameters, len(respSizes))
 	for i, s := range respSizes {
 		respParam[i] = &testpb.ResponseParameters{
-			Size: int32(s),
+			Size:       int32(s),
+			IntervalUs: int32(messageDelay.Microseconds()),
 		}
 	}
 	req := &testpb.StreamingOutputCallRequest{
@@ -142,36 +732,445 @@ func DoServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful server streaming test")
 }
 
-// DoPingPong performs ping-pong style bi-directional streaming RPC.
-func DoPingPong(t crosstesting.TB, client connectpb.TestServiceClient) {
+// DoServerStreamingLargeMessageCount performs a server streaming RPC of 10,000
+// small messages, each with its index embedded in its payload, and checks that
+// they're all received in order, without gaps nor duplicates.
+func DoServerStreamingLargeMessageCount(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const messageCount = 10000
+	respParam := make([]*testpb.ResponseParameters, messageCount)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(eightBytes),
+		}
+	}
+	req := connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	})
+	req.Header().Set(interop.SequencePayloadKey, "true")
+	stream, err := client.StreamingOutputCall(context.Background(), req)
+	require.NoError(t, err)
+	var received, gaps, duplicates, next int
+	for stream.Receive() {
+		received++
+		sequence, err := interop.Sequence(stream.Msg().GetPayload().GetBody())
+		require.NoError(t, err)
+		switch {
+		case sequence < next:
+			duplicates++
+		case sequence > next:
+			gaps += sequence - next
+			next = sequence + 1
+		default:
+			next++
+		}
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+	assert.Equal(t, messageCount, received)
+	assert.Zero(t, gaps, "messages missing from the sequence")
+	assert.Zero(t, duplicates, "messages duplicated or out of order")
+	t.Successf("successful server streaming with large message count")
+}
+
+const floodMessages = 2000
+
+// floodSizes are the sizes the responses of the zero interval flood test cycle
+// through, from the smallest payload with a sequence to frames larger than the
+// common read buffer sizes.
+var floodSizes = []int{eightBytes, 100, oneKiB - 1, 4*oneKiB + 1} // nolint:gochecknoglobals
+
+// DoServerStreamingZeroIntervalFlood performs a server streaming RPC of 2000
+// responses sent back to back, with an interval of zero, so that the client
+// reads frames as fast as the server writes them. Each response has its index
+// embedded in its payload, and must be received in order and with its own size,
+// so that frames dropped, split or coalesced are caught.
+func DoServerStreamingZeroIntervalFlood(t crosstesting.TB, client connectpb.TestServiceClient) {
+	respParam := make([]*testpb.ResponseParameters, floodMessages)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size:       int32(floodSizes[i%len(floodSizes)]),
+			IntervalUs: 0,
+		}
+	}
+	req := connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	})
+	req.Header().Set(interop.SequencePayloadKey, "true")
+	stream, err := client.StreamingOutputCall(context.Background(), req)
+	require.NoError(t, err)
+	var received, mismatched int
+	for stream.Receive() {
+		body := stream.Msg().GetPayload().GetBody()
+		sequence, err := interop.Sequence(body)
+		require.NoError(t, err)
+		if sequence != received || len(body) != floodSizes[received%len(floodSizes)] {
+			mismatched++
+		}
+		received++
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+	assert.Equal(t, floodMessages, received)
+	assert.Zero(t, mismatched, "messages out of order or of the wrong size")
+	t.Successf("successful server streaming zero interval flood")
+}
+
+// DoServerStreamingWithSlowConsumer performs a server streaming RPC of 50
+// messages of about 1 MiB each, sleeping between receiving each of them, so
+// that HTTP/2 flow control has to hold back the server. The messages differ in
+// size so that it's checked that they're received in order.
+func DoServerStreamingWithSlowConsumer(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const (
+		messageCount = 50
+		consumeDelay = 10 * time.Millisecond
+	)
+	respParam := make([]*testpb.ResponseParameters, messageCount)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(oneMiB - i),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	var respCnt int
+	for stream.Receive() {
+		require.Less(t, respCnt, messageCount, "received more than %d messages", messageCount)
+		body := stream.Msg().GetPayload().GetBody()
+		assert.Equal(t, int(respParam[respCnt].GetSize()), len(body), "message %d has the wrong size", respCnt)
+		// compressable payloads are all zeros
+		assert.Equal(t, len(body), bytes.Count(body, []byte{0}), "message %d is corrupted", respCnt)
+		respCnt++
+		time.Sleep(consumeDelay)
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+	assert.Equal(t, messageCount, respCnt)
+	t.Successf("successful server streaming with slow consumer")
+}
+
+// DoServerStreamingBackpressureWithCancel performs a server streaming RPC
+// requesting more responses than the flow control windows can hold, stops
+// reading after the first one, so that the server blocks sending the others,
+// and then cancels the RPC. The number of goroutines of the process must then
+// go back to what it was before the RPC, so the test must run alone: when the
+// server runs in the same process, its handler must have returned too.
+func DoServerStreamingBackpressureWithCancel(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const (
+		messageCount  = 100
+		stuckDelay    = 200 * time.Millisecond
+		unwindTimeout = 5 * time.Second
+	)
+	// open the connection first, so that its goroutines are already counted
+	_, err := client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	goroutines := runtime.NumGoroutine()
+	respParam := make([]*testpb.ResponseParameters, messageCount)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(twoFiftyKiB),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	require.True(t, stream.Receive(), stream.Err())
+	// stop reading, like a stuck consumer, until the flow control windows fill
+	time.Sleep(stuckDelay)
+	cancel()
+	var respCnt int
+	for stream.Receive() {
+		respCnt++
+	}
+	assert.Less(t, respCnt, messageCount-1, "received all the messages despite the cancellation")
+	assert.Equal(t, connect.CodeCanceled, connect.CodeOf(stream.Err()))
+	// the stream is canceled, so discarding the rest of the response body may
+	// fail, over HTTP/3 in particular
+	_ = stream.Close()
+	deadline := time.Now().Add(unwindTimeout)
+	for runtime.NumGoroutine() > goroutines && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines, "goroutines still running %v after the cancellation", unwindTimeout)
+	t.Successf("successful server streaming backpressure with cancel")
+}
+
+// DoServerCompressedStreaming performs a server streaming RPC requesting a
+// compressed response followed by an uncompressed one. Neither test server can
+// opt a single message out of compression, and connect-go doesn't expose the
+// compression of each message to clients, so we assert that the stream was
+// compressed and that both responses are received in order.
+func DoServerCompressedStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	sizes := []int{31415, 92653}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size: int32(sizes[0]),
+				Compressed: &testpb.BoolValue{
+					Value: true,
+				},
+			},
+			{
+				Size: int32(sizes[1]),
+				Compressed: &testpb.BoolValue{
+					Value: false,
+				},
+			},
+		},
+	}
+	stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	var index int
+	for stream.Receive() {
+		require.Less(t, index, len(sizes))
+		assert.Equal(t, stream.Msg().GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+		assert.Equal(t, len(stream.Msg().GetPayload().GetBody()), sizes[index])
+		index++
+	}
+	require.NoError(t, stream.Err())
+	assert.Equal(t, responseCompression(stream.ResponseHeader()), "gzip")
+	require.NoError(t, stream.Close())
+	assert.Equal(t, index, len(sizes))
+	t.Successf("successful server compressed streaming")
+}
+
+// DoPingPong performs ping-pong style bi-directional streaming RPC, asking the
+// server to wait for the message delay before sending each response.
+func DoPingPong(t crosstesting.TB, client connectpb.TestServiceClient, messageDelay time.Duration) {
 	stream := client.FullDuplexCall(context.Background())
 	assert.NotNil(t, stream)
 	var index int
 	for index < len(reqSizes) {
 		respParam := []*testpb.ResponseParameters{
 			{
-				Size: int32(respSizes[index]),
+				Size:       int32(respSizes[index]),
+				IntervalUs: int32(messageDelay.Microseconds()),
 			},
 		}
 		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, reqSizes[index])
 		require.NoError(t, err)
 		req := &testpb.StreamingOutputCallRequest{
 			ResponseType:       testpb.PayloadType_COMPRESSABLE,
-			ResponseParameters: respParam,
-			Payload:            pl,
+			ResponseParameters: respParam,
+			Payload:            pl,
+		}
+		require.NoError(t, stream.Send(req))
+		reply, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, reply.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+		assert.Equal(t, len(reply.GetPayload().GetBody()), respSizes[index])
+		index++
+	}
+	require.NoError(t, stream.CloseRequest())
+	_, err := stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF))
+	require.NoError(t, stream.CloseResponse())
+	t.Successf("successful ping pong")
+}
+
+const (
+	interleavedMessages = 50
+	interleavedInterval = time.Millisecond
+)
+
+// DoInterleavedBidiStreaming sends 50 requests on a full duplex stream before
+// reading any response, with the server waiting briefly before echoing each
+// one. Each request asks for a response of a size unique to its index, and the
+// responses must come back in the order of the requests.
+func DoInterleavedBidiStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	stream := client.FullDuplexCall(context.Background())
+	for i := 0; i < interleavedMessages; i++ {
+		require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseParameters: []*testpb.ResponseParameters{
+				{
+					Size:       int32(i + 1),
+					IntervalUs: int32(interleavedInterval.Microseconds()),
+				},
+			},
+		}), "failed to send request %d", i)
+	}
+	for i := 0; i < interleavedMessages; i++ {
+		reply, err := stream.Receive()
+		require.NoError(t, err, "failed to receive response %d", i)
+		assert.Equal(t, i+1, len(reply.GetPayload().GetBody()), "response %d is out of order", i)
+	}
+	require.NoError(t, stream.CloseRequest())
+	_, err := stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF))
+	require.NoError(t, stream.CloseResponse())
+	t.Successf("successful interleaved bidi streaming")
+}
+
+// DoKeepaliveIdleConnection performs a unary RPC, idles for five keepalive
+// ping intervals, then expects a second unary RPC to succeed. It then does the
+// same within a full duplex stream. Servers enforcing a minimum ping interval,
+// like grpc-go's, send a GOAWAY and close the connection when pinged too often:
+// the unary RPC must then succeed on a new connection, while the stream must
+// fail with the status UNAVAILABLE.
+func DoKeepaliveIdleConnection(t crosstesting.TB, client connectpb.TestServiceClient, interval time.Duration) {
+	idle := 5 * interval
+	newRequest := func() *connect.Request[testpb.SimpleRequest] {
+		return connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		})
+	}
+	_, err := client.UnaryCall(context.Background(), newRequest())
+	require.NoError(t, err)
+	time.Sleep(idle)
+	_, err = client.UnaryCall(context.Background(), newRequest())
+	require.NoError(t, err, "unary call after idling for %v", idle)
+	stream := client.FullDuplexCall(context.Background())
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size: int32(1),
+			},
+		},
+	}
+	require.NoError(t, stream.Send(req))
+	_, err = stream.Receive()
+	require.NoError(t, err)
+	time.Sleep(idle)
+	// Send returns io.EOF if the server closed the connection, the error is
+	// then returned by Receive
+	if err := stream.Send(req); err != nil {
+		assert.True(t, errors.Is(err, io.EOF), "send after idling for %v: %v", idle, err)
+	}
+	_, err = stream.Receive()
+	if err != nil {
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err), "receive after idling for %v: %v", idle, err)
+	}
+	assert.NoError(t, stream.CloseRequest())
+	// closing the response returns the error of a failed stream again
+	if closeErr := stream.CloseResponse(); err == nil {
+		assert.NoError(t, closeErr)
+	}
+	t.Successf("successful keepalive idle connection")
+}
+
+const (
+	concurrentStreams        = 256
+	concurrentStreamMessages = 4
+)
+
+// DoManyConcurrentStreams opens many full duplex streams concurrently on the
+// same client, and exchanges a few messages on each. Each response must have
+// the size requested on its own stream, so that responses mixed up between
+// streams are detected.
+func DoManyConcurrentStreams(t crosstesting.TB, client connectpb.TestServiceClient) {
+	// a deadlocked stream fails with the deadline instead of hanging the test
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errs := make(chan error, concurrentStreams)
+	for i := 0; i < concurrentStreams; i++ {
+		index := i
+		go func() {
+			errs <- concurrentStreamPingPong(ctx, client, index)
+		}()
+	}
+	for i := 0; i < concurrentStreams; i++ {
+		assert.NoError(t, <-errs)
+	}
+	t.Successf("successful many concurrent streams")
+}
+
+func concurrentStreamPingPong(ctx context.Context, client connectpb.TestServiceClient, index int) error {
+	stream := client.FullDuplexCall(ctx)
+	for i := 0; i < concurrentStreamMessages; i++ {
+		size := index*concurrentStreamMessages + i + 1
+		if err := stream.Send(&testpb.StreamingOutputCallRequest{
+			ResponseType:       testpb.PayloadType_COMPRESSABLE,
+			ResponseParameters: []*testpb.ResponseParameters{{Size: int32(size)}},
+		}); err != nil {
+			return fmt.Errorf("stream %d: send %d: %w", index, i, err)
 		}
-		require.NoError(t, stream.Send(req))
 		reply, err := stream.Receive()
-		require.NoError(t, err)
-		assert.Equal(t, reply.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
-		assert.Equal(t, len(reply.GetPayload().GetBody()), respSizes[index])
-		index++
+		if err != nil {
+			return fmt.Errorf("stream %d: receive %d: %w", index, i, err)
+		}
+		if received := len(reply.GetPayload().GetBody()); received != size {
+			return fmt.Errorf("stream %d: response %d has a payload of %d bytes, expected %d", index, i, received, size)
+		}
 	}
-	require.NoError(t, stream.CloseRequest())
-	_, err := stream.Receive()
-	assert.True(t, errors.Is(err, io.EOF))
-	require.NoError(t, stream.CloseResponse())
-	t.Successf("successful ping pong")
+	if err := stream.CloseRequest(); err != nil {
+		return fmt.Errorf("stream %d: close request: %w", index, err)
+	}
+	if _, err := stream.Receive(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("stream %d: expected the end of the stream, got %v", index, err)
+	}
+	return stream.CloseResponse()
+}
+
+// DoMaxConcurrentStreams opens four times as many full duplex streams
+// concurrently as the server's HTTP/2 limit of concurrent streams per
+// connection, and exchanges a few messages on each. None of the streams may
+// fail: the client must either queue the streams over the limit or open new
+// connections for them. The number of new connections is reported, since it
+// shows which.
+func DoMaxConcurrentStreams(t crosstesting.TB, client connectpb.TestServiceClient, transport *ConnCountingTransport, maxStreams int) {
+	streams := 4 * maxStreams
+	// a first call makes sure that the client received the server's limit
+	// before opening the streams
+	_, err := client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	conns := transport.Conns()
+	// a deadlocked stream fails with the deadline instead of hanging the test
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errs := make(chan error, streams)
+	for i := 0; i < streams; i++ {
+		index := i
+		go func() {
+			errs <- concurrentStreamPingPong(ctx, client, index)
+		}()
+	}
+	for i := 0; i < streams; i++ {
+		assert.NoError(t, <-errs)
+	}
+	t.Successf("successful max concurrent streams, using %d new connections", transport.Conns()-conns)
+}
+
+// DoTLSVersionNegotiation checks the TLS handshakes with a server requiring
+// TLS 1.3. The client limited to TLS 1.2 must fail the handshake, with the
+// failure surfaced as an unavailable error, and the client supporting TLS 1.3
+// must negotiate it and succeed.
+func DoTLSVersionNegotiation(t crosstesting.TB, tls12Client, tls13Client connectpb.TestServiceClient) {
+	_, err := tls12Client.EmptyCall(context.Background(), connect.NewRequest(&testpb.Empty{}))
+	require.Error(t, err)
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr), "expected a connect error, got %v", err)
+	assert.Equal(t, connect.CodeUnavailable, connectErr.Code())
+	var state tls.ConnectionState
+	_, err = tls13Client.EmptyCall(withConnectionStateTrace(context.Background(), &state), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), state.Version, "negotiated TLS version")
+	t.Successf("successful TLS version negotiation")
+}
+
+// DoALPNProtocol checks that the client negotiates the expected application
+// protocol with ALPN during the TLS handshake, h2 for HTTP/2 and http/1.1 for
+// HTTP/1.1, and reports it.
+func DoALPNProtocol(t crosstesting.TB, client connectpb.TestServiceClient, protocol string) {
+	var state tls.ConnectionState
+	_, err := client.EmptyCall(withConnectionStateTrace(context.Background(), &state), connect.NewRequest(&testpb.Empty{}))
+	require.NoError(t, err)
+	require.True(t, state.HandshakeComplete, "no TLS connection was observed")
+	assert.Equal(t, protocol, state.NegotiatedProtocol, "negotiated ALPN protocol")
+	t.Successf("successful ALPN protocol negotiation of %q", state.NegotiatedProtocol)
 }
 
 // DoEmptyStream sets up a bi-directional streaming with zero message.
@@ -186,6 +1185,34 @@ func DoEmptyStream(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful empty stream")
 }
 
+// DoEmptyStreamClientStreaming performs a client streaming RPC without sending
+// any requests, and expects an aggregated payload size of zero.
+func DoEmptyStreamClientStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	stream := client.StreamingInputCall(context.Background())
+	reply, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+	assert.Equal(t, reply.Msg.GetAggregatedPayloadSize(), int32(0))
+	t.Successf("successful empty stream client streaming")
+}
+
+// DoEmptyStreamServerStreaming performs a server streaming RPC without any
+// response parameters, and expects the stream to end without any responses.
+func DoEmptyStreamServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+	}
+	stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	var respCnt int
+	for stream.Receive() {
+		respCnt++
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+	assert.Equal(t, respCnt, 0)
+	t.Successf("successful empty stream server streaming")
+}
+
 // DoTimeoutOnSleepingServer performs an RPC on a sleep server which causes RPC timeout.
 func DoTimeoutOnSleepingServer(t crosstesting.TB, client connectpb.TestServiceClient) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
@@ -217,6 +1244,125 @@ func DoTimeoutOnSleepingServer(t crosstesting.TB, client connectpb.TestServiceCl
 	t.Successf("successful timeout on sleep")
 }
 
+// DoDeadlinePropagation performs unary RPCs with deadlines and checks that the
+// time remaining until the deadline observed by the server is within two
+// seconds of the timeout sent by the client. Timeouts of hours check that large
+// timeouts are serialized without overflowing the timeout header, which for
+// gRPC has at most 8 digits, so they are truncated to seconds.
+func DoDeadlinePropagation(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, timeout := range []time.Duration{10 * time.Second, 5 * time.Hour, 90 * time.Hour} {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		req := connect.NewRequest(&testpb.SimpleRequest{})
+		req.Header().Set(echoDeadlineKey, "true")
+		resp, err := client.UnaryCall(ctx, req)
+		cancel()
+		require.NoError(t, err)
+		remaining, err := time.ParseDuration(resp.Header().Get(echoDeadlineKey))
+		require.NoError(t, err)
+		assert.True(t, remaining <= timeout, "server observed %v remaining, over the %v timeout", remaining, timeout)
+		assert.True(t, remaining > timeout-2*time.Second, "server observed %v remaining, for a %v timeout", remaining, timeout)
+	}
+	// A sub-millisecond deadline may expire before the server responds, failing
+	// the call with the status DEADLINE_EXCEEDED.
+	timeout := 500 * time.Microsecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req := connect.NewRequest(&testpb.SimpleRequest{})
+	req.Header().Set(echoDeadlineKey, "true")
+	resp, err := client.UnaryCall(ctx, req)
+	if err != nil {
+		// connect-go codes the error as deadline exceeded only if the transport
+		// returns the context error, which depends on when the deadline expires,
+		// so a wrapped context error is expected otherwise
+		assert.True(
+			t,
+			connect.CodeOf(err) == connect.CodeDeadlineExceeded || errors.Is(err, context.DeadlineExceeded),
+			"unexpected error: %v",
+			err,
+		)
+		t.Successf("successful deadline propagation")
+		return
+	}
+	echoed := resp.Header().Get(echoDeadlineKey)
+	if !strings.HasPrefix(resp.Header().Get("Content-Type"), "application/grpc") {
+		// the Connect protocol sends timeouts in whole milliseconds, and connect-go
+		// omits the timeout when less than a millisecond remains, so the server
+		// observes no deadline
+		assert.Empty(t, echoed, "server observed a deadline the client didn't send")
+		t.Successf("successful deadline propagation")
+		return
+	}
+	require.NotEmpty(t, echoed, "server observed no deadline")
+	remaining, err := time.ParseDuration(echoed)
+	require.NoError(t, err)
+	assert.True(t, remaining <= timeout, "server observed %v remaining, over the %v timeout", remaining, timeout)
+	t.Successf("successful deadline propagation")
+}
+
+// DoUnaryWithServerSideContextDeadlinePropagation performs a unary RPC with a
+// deadline, asking the server to sleep past it, and expects the status
+// DEADLINE_EXCEEDED. It then asks the server to sleep briefly without a
+// deadline, and expects the call to succeed.
+func DoUnaryWithServerSideContextDeadlinePropagation(t crosstesting.TB, client connectpb.TestServiceClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req := connect.NewRequest(&testpb.SimpleRequest{})
+	req.Header().Set(interop.UnarySleepKey, (2 * time.Second).String())
+	start := time.Now()
+	_, err := client.UnaryCall(ctx, req)
+	assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err), "unexpected error: %v", err)
+	assert.Less(t, time.Since(start), 2*time.Second, "call didn't fail before the server finished sleeping")
+	// without a deadline, the server sleeps for the whole duration
+	req = connect.NewRequest(&testpb.SimpleRequest{})
+	req.Header().Set(interop.UnarySleepKey, (50 * time.Millisecond).String())
+	_, err = client.UnaryCall(context.Background(), req)
+	require.NoError(t, err)
+	t.Successf("successful unary with server-side context deadline propagation")
+}
+
+// DoDeadlineExceededServerStreaming performs a server streaming RPC with a
+// deadline that expires while the server sleeps before sending its last
+// response. The responses sent before the deadline must be received before the
+// stream fails with a deadline exceeded error, while the client is blocked
+// reading the last response.
+func DoDeadlineExceededServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	sizes := []int{31415, 9}
+	respParam := make([]*testpb.ResponseParameters, 0, len(sizes)+1)
+	for _, size := range sizes {
+		respParam = append(respParam, &testpb.ResponseParameters{
+			Size: int32(size),
+		})
+	}
+	respParam = append(respParam, &testpb.ResponseParameters{
+		Size:       int32(2653),
+		IntervalUs: int32((2 * time.Second).Microseconds()),
+	})
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	for _, size := range sizes {
+		require.True(t, stream.Receive())
+		assert.Equal(t, len(stream.Msg().GetPayload().GetBody()), size)
+	}
+	assert.False(t, stream.Receive())
+	err = stream.Err()
+	require.Error(t, err)
+	// connect-go v0.2.0 deviates when the deadline expires during a blocked read:
+	// it wraps the context error without coding it, as an unknown error or an
+	// incomplete envelope, so only then is the context error expected in place
+	// of the code
+	if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "unexpected error: %v", err)
+	}
+	_ = stream.Close()
+	t.Successf("successful deadline exceeded server streaming")
+}
+
 var testMetadata = metadata.MD{ // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
 	"key1": []string{"value1"},
 	"key2": []string{"value2"},
@@ -234,6 +1380,98 @@ func DoCancelAfterBegin(t crosstesting.TB, client connectpb.TestServiceClient) {
 	t.Successf("successful cancel after begin")
 }
 
+// DoCancelAfterBeginServerStreaming cancels a server streaming RPC after the
+// request has been sent but before any response is received.
+func DoCancelAfterBeginServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size:       31415,
+				IntervalUs: int32((1 * time.Second).Microseconds()),
+			},
+		},
+	}
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	cancel()
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeOf(stream.Err()), connect.CodeCanceled)
+	_ = stream.Close()
+	t.Successf("successful cancel after begin server streaming")
+}
+
+// DoCancelDuringServerStreaming cancels a server streaming RPC after receiving
+// some of the responses, while the server waits to send the next one.
+func DoCancelDuringServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const (
+		responses = 10
+		received  = 3
+		interval  = 100 * time.Millisecond
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	respParam := make([]*testpb.ResponseParameters, responses)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size:       31415,
+			IntervalUs: int32(interval.Microseconds()),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	for i := 0; i < received; i++ {
+		require.True(t, stream.Receive(), "failed to receive response %d: %v", i, stream.Err())
+		assert.Equal(t, 31415, len(stream.Msg().GetPayload().GetBody()))
+	}
+	start := time.Now()
+	cancel()
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeCanceled, connect.CodeOf(stream.Err()))
+	// the stream ends without waiting for the next response
+	assert.Less(t, time.Since(start), interval)
+	_ = stream.Close()
+	t.Successf("successful cancel during server streaming")
+}
+
+// DoCancelAfterFirstResponseServerStreaming cancels a server streaming RPC
+// after receiving exactly one response, while the server waits to send the
+// next one.
+func DoCancelAfterFirstResponseServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const interval = time.Second
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size: 31415,
+			},
+			{
+				Size:       31415,
+				IntervalUs: int32(interval.Microseconds()),
+			},
+		},
+	}
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	require.True(t, stream.Receive(), "failed to receive the first response: %v", stream.Err())
+	assert.Equal(t, 31415, len(stream.Msg().GetPayload().GetBody()))
+	start := time.Now()
+	cancel()
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeCanceled, connect.CodeOf(stream.Err()))
+	// the stream ends without waiting for the second response
+	assert.Less(t, time.Since(start), interval)
+	_ = stream.Close()
+	t.Successf("successful cancel after first response server streaming")
+}
+
 // DoCancelAfterFirstResponse cancels the RPC after receiving the first message from the server.
 func DoCancelAfterFirstResponse(t crosstesting.TB, client connectpb.TestServiceClient) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -262,114 +1500,518 @@ func DoCancelAfterFirstResponse(t crosstesting.TB, client connectpb.TestServiceC
 	t.Successf("successful cancel after first response")
 }
 
+const firstResponseDelay = 200 * time.Millisecond
+
+// DoRaceHeadersAndBody asks the server to set the response headers of a full
+// duplex call and wait before sending the first response. Servers that flush
+// the headers as soon as they're set, like grpc-go, mark them with the
+// interop.HeadersFlushedKey header, and the headers must then be available
+// before the delay elapses. connect-go servers can't flush the headers before
+// the first response, so otherwise the headers must arrive with it.
+func DoRaceHeadersAndBody(t crosstesting.TB, client connectpb.TestServiceClient) {
+	stream := client.FullDuplexCall(context.Background())
+	withEchoMetadata(stream.RequestHeader(), []string{leadingMetadataValue}, nil)
+	stream.RequestHeader().Set(interop.FirstResponseDelayKey, firstResponseDelay.String())
+	start := time.Now()
+	require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+	}))
+	// ResponseHeader blocks until the headers are received
+	header := stream.ResponseHeader()
+	headerElapsed := time.Since(start)
+	assert.Equal(t, []string{leadingMetadataValue}, header.Values(leadingMetadataKey))
+	_, err := stream.Receive()
+	require.NoError(t, err)
+	receiveElapsed := time.Since(start)
+	assert.GreaterOrEqual(t, receiveElapsed, firstResponseDelay, "first response received before the delay elapsed")
+	require.NoError(t, stream.CloseRequest())
+	_, err = stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF))
+	require.NoError(t, stream.CloseResponse())
+	if header.Get(interop.HeadersFlushedKey) != "" {
+		assert.Less(t, headerElapsed, firstResponseDelay, "flushed headers received after the delay")
+		t.Successf("successful race headers and body, with the headers flushed before the first response")
+		return
+	}
+	assert.GreaterOrEqual(t, headerElapsed, firstResponseDelay, "headers received before the first response")
+	t.Successf("successful race headers and body, with the headers sent with the first response")
+}
+
 const (
 	leadingMetadataValue  = "test_initial_metadata_value"
 	trailingMetadataValue = "\x0a\x0b\x0a\x0b\x0a\x0b"
 )
 
-func validateMetadata(
-	t crosstesting.TB,
-	header http.Header,
-	trailer http.Header,
-	expectedStringHeaders map[string][]string,
-	expectedBinaryHeaders map[string][][]byte,
-) {
-	for key, values := range expectedStringHeaders {
-		assert.Equal(t, len(values), len(header.Values(key)))
-		valuesMap := map[string]struct{}{}
-		for _, value := range values {
-			valuesMap[value] = struct{}{}
-		}
-		for _, headerValue := range header.Values(key) {
-			_, ok := valuesMap[headerValue]
-			assert.True(t, ok)
-		}
+var (
+	// the values of the repeated metadata tests are distinct, so that their
+	// order can be checked
+	repeatedLeadingMetadataValues = []string{ // nolint:gochecknoglobals
+		leadingMetadataValue + "-1",
+		leadingMetadataValue + "-2",
+		leadingMetadataValue + "-3",
+	}
+	repeatedTrailingMetadataValues = [][]byte{ // nolint:gochecknoglobals
+		[]byte(trailingMetadataValue + "\x01"),
+		[]byte(trailingMetadataValue + "\x02"),
+		[]byte(trailingMetadataValue + "\x03"),
+	}
+)
+
+func validateMetadata(
+	t crosstesting.TB,
+	header http.Header,
+	trailer http.Header,
+	initial []string,
+	trailing [][]byte,
+) {
+	assert.ElementsMatch(t, initial, header.Values(leadingMetadataKey))
+	echoedTrailing, err := decodeBinaryValues(trailer.Values(trailingMetadataKey))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, trailing, echoedTrailing)
+}
+
+// DoCustomMetadataUnary checks that metadata is echoed back to the client with unary call.
+func DoCustomMetadataUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
+	customMetadataUnaryTest(
+		t,
+		client,
+		[]string{leadingMetadataValue},
+		[][]byte{[]byte(trailingMetadataValue)},
+	)
+	t.Successf("successful custom metadata unary")
+}
+
+// DoRepeatedMetadata adds the same metadata keys several times, with distinct
+// values, and checks that all the values are echoed back in order with unary
+// call, unlike the duplicated metadata tests, which ignore the order.
+func DoRepeatedMetadata(t crosstesting.TB, client connectpb.TestServiceClient) {
+	request := connect.NewRequest(&testpb.SimpleRequest{})
+	withEchoMetadata(request.Header(), repeatedLeadingMetadataValues, repeatedTrailingMetadataValues)
+	reply, err := client.UnaryCall(context.Background(), request)
+	require.NoError(t, err)
+	validateRepeatedMetadata(t, reply.Header(), reply.Trailer())
+	t.Successf("successful repeated metadata")
+}
+
+// unsupportedPayloadType is a payload type the test servers don't support.
+const unsupportedPayloadType = testpb.PayloadType(-1)
+
+const (
+	headerOnlyMetadataValue  = "header-only-value"
+	trailerOnlyMetadataValue = "trailer-only-value"
+)
+
+// DoUnaryWithResponseHeaderAndTrailerEcho asks the server to echo distinct
+// initial and trailing metadata on a successful unary call, and checks that
+// each arrives in its own place: the initial value in the headers only, and the
+// trailing value in the trailers only, under any key.
+func DoUnaryWithResponseHeaderAndTrailerEcho(t crosstesting.TB, client connectpb.TestServiceClient) {
+	request := connect.NewRequest(&testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+	})
+	withEchoMetadata(request.Header(), []string{headerOnlyMetadataValue}, [][]byte{[]byte(trailerOnlyMetadataValue)})
+	reply, err := client.UnaryCall(context.Background(), request)
+	require.NoError(t, err)
+	validateMetadata(t, reply.Header(), reply.Trailer(), []string{headerOnlyMetadataValue}, [][]byte{[]byte(trailerOnlyMetadataValue)})
+	assert.Empty(t, reply.Header().Values(trailingMetadataKey))
+	assert.Empty(t, reply.Trailer().Values(leadingMetadataKey))
+	encodedTrailerValue := connect.EncodeBinaryHeader([]byte(trailerOnlyMetadataValue))
+	for key, values := range reply.Header() {
+		assert.NotContains(t, values, trailerOnlyMetadataValue, "header %s", key)
+		assert.NotContains(t, values, encodedTrailerValue, "header %s", key)
+	}
+	for key, values := range reply.Trailer() {
+		assert.NotContains(t, values, headerOnlyMetadataValue, "trailer %s", key)
+	}
+	t.Successf("successful unary with response header and trailer echo")
+}
+
+const (
+	manyTrailersCount    = 100
+	manyTrailersLongSize = oneKiB
+)
+
+// DoManyTrailers asks the server to attach 100 distinct trailers with short
+// values to a unary response, and checks that all of them are received without
+// truncation.
+func DoManyTrailers(t crosstesting.TB, client connectpb.TestServiceClient) {
+	manyTrailersTest(t, client, 0)
+	t.Successf("successful many trailers")
+}
+
+// DoManyLongTrailers is the same as DoManyTrailers with values of 1 KiB.
+func DoManyLongTrailers(t crosstesting.TB, client connectpb.TestServiceClient) {
+	manyTrailersTest(t, client, manyTrailersLongSize)
+	t.Successf("successful many long trailers")
+}
+
+func manyTrailersTest(t crosstesting.TB, client connectpb.TestServiceClient, size int) {
+	t.Helper()
+	request := connect.NewRequest(&testpb.SimpleRequest{})
+	request.Header().Set(interop.TrailerCountKey, strconv.Itoa(manyTrailersCount))
+	request.Header().Set(interop.TrailerSizeKey, strconv.Itoa(size))
+	reply, err := client.UnaryCall(context.Background(), request)
+	require.NoError(t, err)
+	for key, value := range interop.NewTrailers(manyTrailersCount, size) {
+		assert.Equal(t, value, reply.Trailer().Get(key), "trailer %s", key)
+	}
+}
+
+// DoRepeatedMetadataFullDuplex is the same as DoRepeatedMetadata with full
+// duplex call.
+func DoRepeatedMetadataFullDuplex(t crosstesting.TB, client connectpb.TestServiceClient) {
+	stream := client.FullDuplexCall(context.Background())
+	withEchoMetadata(stream.RequestHeader(), repeatedLeadingMetadataValues, repeatedTrailingMetadataValues)
+	require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+	}))
+	_, err := stream.Receive()
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseRequest())
+	_, err = stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF))
+	require.NoError(t, stream.CloseResponse())
+	validateRepeatedMetadata(t, stream.ResponseHeader(), stream.ResponseTrailer())
+	t.Successf("successful repeated metadata full duplex")
+}
+
+func validateRepeatedMetadata(t crosstesting.TB, header, trailer http.Header) {
+	assert.Equal(t, repeatedLeadingMetadataValues, header.Values(leadingMetadataKey))
+	echoedTrailing, err := decodeBinaryValues(trailer.Values(trailingMetadataKey))
+	require.NoError(t, err)
+	assert.Equal(t, repeatedTrailingMetadataValues, echoedTrailing)
+}
+
+// DoRequestResponseWithMetadataInTrailerOnly checks that trailing metadata
+// echoed without any initial metadata by a successful unary call is delivered
+// in the trailers only, and doesn't leak into the headers.
+func DoRequestResponseWithMetadataInTrailerOnly(t crosstesting.TB, client connectpb.TestServiceClient) {
+	payload, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 1)
+	require.NoError(t, err)
+	request := connect.NewRequest(&testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+		Payload:      payload,
+	})
+	withEchoMetadata(request.Header(), nil, [][]byte{[]byte(trailingMetadataValue)})
+	reply, err := client.UnaryCall(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), 1)
+	assert.Empty(t, reply.Header().Values(leadingMetadataKey))
+	assert.Empty(t, reply.Header().Values(trailingMetadataKey))
+	assert.Empty(t, reply.Trailer().Values(leadingMetadataKey))
+	validateMetadata(t, reply.Header(), reply.Trailer(), nil, [][]byte{[]byte(trailingMetadataValue)})
+	t.Successf("successful request response with metadata in trailer only")
+}
+
+func DoCustomMetadataServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	customMetadataServerStreamingTest(
+		t,
+		client,
+		[]string{leadingMetadataValue},
+		[][]byte{[]byte(trailingMetadataValue)},
+	)
+	t.Successf("successful custom metadata server streaming")
+}
+
+// DoCustomMetadataFullDuplex checks that metadata is echoed back to the client with full duplex call.
+func DoCustomMetadataFullDuplex(t crosstesting.TB, client connectpb.TestServiceClient) {
+	customMetadataFullDuplexTest(
+		t,
+		client,
+		[]string{leadingMetadataValue},
+		[][]byte{[]byte(trailingMetadataValue)},
+	)
+	t.Successf("successful custom metadata full duplex")
+}
+
+// DoDuplicatedCustomMetadataUnary adds duplicated metadata keys and checks that the metadata is echoed back
+// to the client with unary call.
+func DoDuplicatedCustomMetadataUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
+	customMetadataUnaryTest(
+		t,
+		client,
+		[]string{leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
+		[][]byte{[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
+	)
+	t.Successf("successful duplicated custom metadata unary")
+}
+
+// DoPeerAddress asks the server to echo the address of the peer of a unary RPC
+// in a response header, with a client connected over IPv4, then with one
+// connected over IPv6, and checks that the echoed addresses are IP:port pairs
+// of the IP version of the client. The IPv6 client is nil where IPv6 isn't
+// available.
+func DoPeerAddress(t crosstesting.TB, ipv4Client, ipv6Client connectpb.TestServiceClient) {
+	peerTests := []struct {
+		client connectpb.TestServiceClient
+		ipv6   bool
+	}{
+		{client: ipv4Client},
+		{client: ipv6Client, ipv6: true},
+	}
+	for _, peerTest := range peerTests {
+		if peerTest.client == nil {
+			continue
+		}
+		req := connect.NewRequest(&testpb.SimpleRequest{})
+		req.Header().Set(echoPeerKey, "true")
+		resp, err := peerTest.client.UnaryCall(context.Background(), req)
+		require.NoError(t, err)
+		peer := resp.Header().Get(echoPeerKey)
+		host, port, err := net.SplitHostPort(peer)
+		require.NoError(t, err, "invalid peer address %q", peer)
+		ip := net.ParseIP(host)
+		require.NotNil(t, ip, "invalid IP in peer address %q", peer)
+		assert.Equal(t, peerTest.ipv6, ip.To4() == nil, "unexpected IP version in peer address %q", peer)
+		portNumber, err := strconv.ParseUint(port, 10, 16)
+		require.NoError(t, err, "invalid port in peer address %q", peer)
+		assert.NotZero(t, portNumber, "invalid port in peer address %q", peer)
+	}
+	t.Successf("successful peer address")
+}
+
+// DoPerRPCCredentials performs a unary RPC with a client attaching the bearer
+// token expected by the server, then expects the same RPC to fail with the
+// status UNAUTHENTICATED without a token and with an invalid one.
+func DoPerRPCCredentials(t crosstesting.TB, client, unauthenticatedClient connectpb.TestServiceClient, token string) {
+	newRequest := func() *connect.Request[testpb.SimpleRequest] {
+		return connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		})
+	}
+	_, err := client.UnaryCall(context.Background(), newRequest())
+	require.NoError(t, err)
+	_, err = unauthenticatedClient.UnaryCall(context.Background(), newRequest())
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	req := newRequest()
+	req.Header().Set(interop.AuthorizationKey, interop.BearerToken(token+"-invalid"))
+	_, err = unauthenticatedClient.UnaryCall(context.Background(), req)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	t.Successf("successful per rpc credentials")
+}
+
+// UnaryCallProcedure is the procedure of UnaryCall, which the client of
+// DoRetryOnUnavailable is expected to retry.
+const UnaryCallProcedure = "/grpc.testing.TestService/UnaryCall"
+
+// DoRetryOnUnavailable performs unary RPCs asking the server to fail the first
+// attempts with the status UNAVAILABLE, with a client retrying UnaryCall up to
+// maxRetries times after the given backoff, doubled for each retry. It expects
+// the call to succeed after waiting for the backoffs when the server fails all
+// but the last attempt, and the error of the last attempt when the server
+// fails them all, which shows that the code of connect errors is enough to
+// drive retries.
+func DoRetryOnUnavailable(t crosstesting.TB, client connectpb.TestServiceClient, maxRetries int, backoff time.Duration) {
+	newRequest := func(failAttempts int) *connect.Request[testpb.SimpleRequest] {
+		req := connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		})
+		req.Header().Set(interop.FailAttemptsKey, strconv.Itoa(failAttempts))
+		return req
+	}
+	var minWait time.Duration
+	for wait, retry := backoff, 0; retry < maxRetries; wait, retry = wait*2, retry+1 {
+		minWait += wait
+	}
+	start := time.Now()
+	_, err := client.UnaryCall(context.Background(), newRequest(maxRetries))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), minWait)
+	_, err = client.UnaryCall(context.Background(), newRequest(maxRetries+1))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, interop.FailedAttemptErrMsg(maxRetries+1), connectErr.Message())
+	t.Successf("successful retry on unavailable")
+}
+
+// DoCacheableUnaryCall performs a CacheableUnaryCall, and checks that the
+// response has the cache control header letting a caching proxy serve
+// subsequent requests. connect-go doesn't support sending unary calls with GET
+// yet, so the call is a POST, which proxies don't cache.
+func DoCacheableUnaryCall(t crosstesting.TB, client connectpb.TestServiceClient) {
+	resp, err := client.CacheableUnaryCall(
+		context.Background(),
+		connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, interop.CacheControl, resp.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, resp.Msg.GetPayload().GetBody())
+	t.Successf("successful cacheable unary call")
+}
+
+// DoUnaryCallWithCustomUserAgent performs unary RPCs asking the server to echo
+// the user-agent it observed. The default user-agent of connect-go must contain
+// its version, and the user-agent set by the client with the custom user-agent
+// must reach the server as is.
+func DoUnaryCallWithCustomUserAgent(t crosstesting.TB, client, userAgentClient connectpb.TestServiceClient, userAgent string) {
+	echoUserAgent := func(client connectpb.TestServiceClient) string {
+		req := connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		})
+		req.Header().Set(echoUserAgentKey, "true")
+		resp, err := client.UnaryCall(context.Background(), req)
+		require.NoError(t, err)
+		return resp.Header().Get(echoUserAgentKey)
 	}
-	for key, values := range expectedBinaryHeaders {
-		assert.Equal(t, len(values), len(trailer.Values(key)))
-		valuesMap := map[string]struct{}{}
-		for _, value := range values {
-			valuesMap[string(value)] = struct{}{}
-		}
-		for _, trailerValue := range trailer.Values(key) {
-			decodedTrailerValue, err := connect.DecodeBinaryHeader(trailerValue)
-			assert.NoError(t, err)
-			_, ok := valuesMap[string(decodedTrailerValue)]
-			assert.True(t, ok)
+	defaultUserAgent := echoUserAgent(client)
+	// connect-go names itself differently for each protocol, so only check the
+	// version
+	assert.Contains(t, defaultUserAgent, "/"+connect.Version, "default user-agent %q", defaultUserAgent)
+	assert.Equal(t, userAgent, echoUserAgent(userAgentClient))
+	t.Successf("successful unary call with custom user-agent")
+}
+
+// DoOversizedMetadata sends unary calls with increasingly large leading
+// metadata values, and checks that each value is either echoed back without
+// truncation or rejected with the status RESOURCE_EXHAUSTED.
+func DoOversizedMetadata(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, size := range oversizedMetadataSizes {
+		value := strings.Repeat("a", size)
+		req := connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		})
+		req.Header().Set(leadingMetadataKey, value)
+		resp, err := client.UnaryCall(context.Background(), req)
+		if err != nil {
+			assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err), "%d bytes of metadata: %v", size, err)
+			continue
 		}
+		assert.Equal(t, value, resp.Header().Get(leadingMetadataKey), "%d bytes of metadata", size)
 	}
+	t.Successf("successful oversized metadata")
 }
 
-// DoCustomMetadataUnary checks that metadata is echoed back to the client with unary call.
-func DoCustomMetadataUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
+// DoBinaryMetadata performs a unary RPC with binary trailing metadata made of
+// adversarial byte sequences, including NUL and 0xFF bytes, and expects the
+// exact bytes to be echoed back. The values' lengths cover each base64 padding
+// case, as well as the empty value.
+func DoBinaryMetadata(t crosstesting.TB, client connectpb.TestServiceClient) {
 	customMetadataUnaryTest(
 		t,
 		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue)},
+		nil,
+		[][]byte{
+			{},
+			{0x00},
+			{0xff, 0x00},
+			bytes.Repeat([]byte{0x00, 0xff, 0xfe}, 85),
 		},
 	)
-	t.Successf("successful custom metadata unary")
+	t.Successf("successful binary metadata")
 }
 
-func DoCustomMetadataServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
-	customMetadataServerStreamingTest(
-		t,
-		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue)},
-		},
-	)
-	t.Successf("successful custom metadata server streaming")
+// DoLargeMetadataBinaryTrailer performs a unary RPC with a binary trailing
+// metadata value of about 4 KiB, a serialized payload containing all 256 byte
+// values, and expects the exact bytes to be echoed back.
+func DoLargeMetadataBinaryTrailer(t crosstesting.TB, client connectpb.TestServiceClient) {
+	value, err := interop.NewLargeBinaryTrailer()
+	require.NoError(t, err)
+	customMetadataUnaryTest(t, client, nil, [][]byte{value})
+	t.Successf("successful large metadata binary trailer")
 }
 
-// DoCustomMetadataFullDuplex checks that metadata is echoed back to the client with full duplex call.
-func DoCustomMetadataFullDuplex(t crosstesting.TB, client connectpb.TestServiceClient) {
-	customMetadataFullDuplexTest(
-		t,
-		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue)},
-		},
+// DoOrcaPerRPC performs a unary RPC asking the server to record an ORCA load
+// report, and checks that the report is attached to the response trailers.
+func DoOrcaPerRPC(t crosstesting.TB, client connectpb.TestServiceClient) {
+	orcaReport := &testpb.TestOrcaReport{
+		CpuUtilization:    0.8210,
+		MemoryUtilization: 0.5847,
+		RequestCost:       map[string]float64{"cost": 3456.32},
+		Utilization:       map[string]float64{"util": 0.30499},
+	}
+	resp, err := client.UnaryCall(
+		context.Background(),
+		connect.NewRequest(&testpb.SimpleRequest{
+			OrcaPerQueryReport: orcaReport,
+		}),
 	)
-	t.Successf("successful custom metadata full duplex")
+	require.NoError(t, err)
+	value := resp.Trailer().Get(interop.OrcaLoadReportKey)
+	require.NotEmpty(t, value, "missing %s trailer", interop.OrcaLoadReportKey)
+	decoded, err := connect.DecodeBinaryHeader(value)
+	require.NoError(t, err)
+	loadReport := &orcav3.OrcaLoadReport{}
+	require.NoError(t, proto.Unmarshal(decoded, loadReport))
+	assert.Equal(t, orcaReport.CpuUtilization, loadReport.CpuUtilization)
+	assert.Equal(t, orcaReport.MemoryUtilization, loadReport.MemUtilization)
+	assert.Equal(t, orcaReport.RequestCost, loadReport.RequestCost)
+	assert.Equal(t, orcaReport.Utilization, loadReport.Utilization)
+	t.Successf("successful orca per rpc")
 }
 
-// DoDuplicatedCustomMetadataUnary adds duplicated metadata keys and checks that the metadata is echoed back
-// to the client with unary call.
-func DoDuplicatedCustomMetadataUnary(t crosstesting.TB, client connectpb.TestServiceClient) {
-	customMetadataUnaryTest(
-		t,
-		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
-		},
+// DoServerReflection lists the services of the server with the gRPC server
+// reflection protocol (v1alpha), expecting the test service to be listed, then
+// asks for the file declaring the test service.
+func DoServerReflection(t crosstesting.TB, client *ServerReflectionClient) {
+	stream := client.ServerReflectionInfo(context.Background())
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	}))
+	resp, err := stream.Receive()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Contains(t, services, "grpc.testing.TestService")
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "grpc.testing.TestService"},
+	}))
+	resp, err = stream.Receive()
+	require.NoError(t, err)
+	fileDescriptors := resp.GetFileDescriptorResponse().GetFileDescriptorProto()
+	require.NotEmpty(t, fileDescriptors, "unexpected response: %v", resp)
+	// the file containing the symbol comes before its dependencies
+	fileDescriptor := &descriptorpb.FileDescriptorProto{}
+	require.NoError(t, proto.Unmarshal(fileDescriptors[0], fileDescriptor))
+	assert.Equal(t, "grpc/testing/test.proto", fileDescriptor.GetName())
+	require.NoError(t, stream.CloseRequest())
+	_, err = stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF))
+	require.NoError(t, stream.CloseResponse())
+	t.Successf("successful server reflection")
+}
+
+// DoHealthCheck checks the health of the server and of the test service with
+// the gRPC health checking protocol, expecting both to be serving, and expects
+// checking an unknown service to fail with the status NOT_FOUND.
+func DoHealthCheck(t crosstesting.TB, client healthv1connect.HealthClient) {
+	for _, service := range []string{"", "grpc.testing.TestService"} {
+		resp, err := client.Check(
+			context.Background(),
+			connect.NewRequest(&healthv1.HealthCheckRequest{Service: service}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.Msg.GetStatus(), "service %q", service)
+	}
+	_, err := client.Check(
+		context.Background(),
+		connect.NewRequest(&healthv1.HealthCheckRequest{Service: "grpc.testing.UnknownService"}),
 	)
-	t.Successf("successful duplicated custom metadata unary")
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	t.Successf("successful health check")
 }
 
 func DoDuplicatedCustomMetadataServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
 	customMetadataServerStreamingTest(
 		t,
 		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
-		},
+		[]string{leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
+		[][]byte{[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
 	)
 	t.Successf("successful duplicated custom metadata server streaming")
 }
@@ -380,12 +2022,8 @@ func DoDuplicatedCustomMetadataFullDuplex(t crosstesting.TB, client connectpb.Te
 	customMetadataFullDuplexTest(
 		t,
 		client,
-		map[string][]string{
-			leadingMetadataKey: {leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
-		},
-		map[string][][]byte{
-			trailingMetadataKey: {[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
-		},
+		[]string{leadingMetadataValue, leadingMetadataValue + ",more_stuff"},
+		[][]byte{[]byte(trailingMetadataValue), []byte(trailingMetadataValue + "\x0a")},
 	)
 	t.Successf("successful duplicated custom metadata full duplex")
 }
@@ -393,8 +2031,8 @@ func DoDuplicatedCustomMetadataFullDuplex(t crosstesting.TB, client connectpb.Te
 func customMetadataUnaryTest(
 	t crosstesting.TB,
 	client connectpb.TestServiceClient,
-	customMetadataString map[string][]string,
-	customMetadataBinary map[string][][]byte,
+	initial []string,
+	trailing [][]byte,
 ) {
 	// Testing with UnaryCall.
 	payload, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 1)
@@ -406,16 +2044,7 @@ func customMetadataUnaryTest(
 	}
 	ctx := context.Background()
 	connectReq := connect.NewRequest(req)
-	for key, values := range customMetadataString {
-		for _, value := range values {
-			connectReq.Header().Add(key, value)
-		}
-	}
-	for key, values := range customMetadataBinary {
-		for _, value := range values {
-			connectReq.Header().Add(key, connect.EncodeBinaryHeader(value))
-		}
-	}
+	withEchoMetadata(connectReq.Header(), initial, trailing)
 	reply, err := client.UnaryCall(
 		ctx,
 		connectReq,
@@ -423,14 +2052,14 @@ func customMetadataUnaryTest(
 	require.NoError(t, err)
 	assert.Equal(t, reply.Msg.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
 	assert.Equal(t, len(reply.Msg.GetPayload().GetBody()), 1)
-	validateMetadata(t, reply.Header(), reply.Trailer(), customMetadataString, customMetadataBinary)
+	validateMetadata(t, reply.Header(), reply.Trailer(), initial, trailing)
 }
 
 func customMetadataServerStreamingTest(
 	t crosstesting.TB,
 	client connectpb.TestServiceClient,
-	customMetadataString map[string][]string,
-	customMetadataBinary map[string][][]byte,
+	initial []string,
+	trailing [][]byte,
 ) {
 	payload, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 1)
 	require.NoError(t, err)
@@ -444,30 +2073,21 @@ func customMetadataServerStreamingTest(
 		ResponseParameters: respParam,
 		Payload:            payload,
 	})
-	for key, values := range customMetadataString {
-		for _, value := range values {
-			req.Header().Add(key, value)
-		}
-	}
-	for key, values := range customMetadataBinary {
-		for _, value := range values {
-			req.Header().Add(key, connect.EncodeBinaryHeader(value))
-		}
-	}
+	withEchoMetadata(req.Header(), initial, trailing)
 	stream, err := client.StreamingOutputCall(context.Background(), req)
 	require.NoError(t, err)
 	for stream.Receive() {
 		require.NoError(t, stream.Err())
 	}
 	assert.NoError(t, stream.Close())
-	validateMetadata(t, stream.ResponseHeader(), stream.ResponseTrailer(), customMetadataString, customMetadataBinary)
+	validateMetadata(t, stream.ResponseHeader(), stream.ResponseTrailer(), initial, trailing)
 }
 
 func customMetadataFullDuplexTest(
 	t crosstesting.TB,
 	client connectpb.TestServiceClient,
-	customMetadataString map[string][]string,
-	customMetadataBinary map[string][][]byte,
+	initial []string,
+	trailing [][]byte,
 ) {
 	payload, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 1)
 	require.NoError(t, err)
@@ -484,16 +2104,7 @@ func customMetadataFullDuplexTest(
 		ResponseParameters: respParam,
 		Payload:            payload,
 	}
-	for key, values := range customMetadataString {
-		for _, value := range values {
-			stream.RequestHeader().Add(key, value)
-		}
-	}
-	for key, values := range customMetadataBinary {
-		for _, value := range values {
-			stream.RequestHeader().Add(key, connect.EncodeBinaryHeader(value))
-		}
-	}
+	withEchoMetadata(stream.RequestHeader(), initial, trailing)
 	require.NoError(t, stream.Send(streamReq))
 	_, err = stream.Receive()
 	require.NoError(t, err)
@@ -501,7 +2112,7 @@ func customMetadataFullDuplexTest(
 	_, err = stream.Receive()
 	assert.True(t, errors.Is(err, io.EOF))
 	require.NoError(t, stream.CloseResponse())
-	validateMetadata(t, stream.ResponseHeader(), stream.ResponseTrailer(), customMetadataString, customMetadataBinary)
+	validateMetadata(t, stream.ResponseHeader(), stream.ResponseTrailer(), initial, trailing)
 }
 
 // DoStatusCodeAndMessageUnary checks that the status code is propagated back to the client with unary call.
@@ -527,6 +2138,143 @@ func DoStatusCodeAndMessageUnary(t crosstesting.TB, client connectpb.TestService
 	t.Successf("successful code and message unary")
 }
 
+// DoAllStatusCodes performs a unary RPC for each error code, from
+// CodeCanceled to CodeUnauthenticated, and checks that the code and message
+// requested from the server are propagated back to the client.
+func DoAllStatusCodes(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for code := connect.CodeCanceled; code <= connect.CodeUnauthenticated; code++ {
+		msg := "test status message for " + code.String()
+		req := &testpb.SimpleRequest{
+			ResponseStatus: &testpb.EchoStatus{
+				Code:    int32(code),
+				Message: msg,
+			},
+		}
+		_, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+		require.Error(t, err, "code %v", code)
+		assert.Equal(t, code, connect.CodeOf(err))
+		assert.Equal(t, connect.NewError(code, errors.New(msg)).Error(), err.Error())
+	}
+	t.Successf("successful all status codes")
+}
+
+// DoUnaryWithInvalidArgument performs unary RPCs requesting a response with
+// a negative size, and with an unsupported payload type, and expects the
+// server to reject both as invalid arguments.
+func DoUnaryWithInvalidArgument(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, req := range []*testpb.SimpleRequest{
+		{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseSize: -1},
+		{ResponseType: unsupportedPayloadType, ResponseSize: 1},
+	} {
+		_, err := client.UnaryCall(context.Background(), connect.NewRequest(req))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "response type %v and size %d", req.ResponseType, req.ResponseSize)
+	}
+	t.Successf("successful unary with invalid argument")
+}
+
+// DoServerStreamingWithInvalidArgument performs server streaming RPCs whose
+// second response has a negative size, and whose responses have an
+// unsupported payload type, and expects the server to fail both streams as
+// invalid arguments, after sending the first response of the former.
+func DoServerStreamingWithInvalidArgument(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, testCase := range []struct {
+		req       *testpb.StreamingOutputCallRequest
+		responses int
+	}{
+		{
+			req: &testpb.StreamingOutputCallRequest{
+				ResponseType:       testpb.PayloadType_COMPRESSABLE,
+				ResponseParameters: []*testpb.ResponseParameters{{Size: 1}, {Size: -1}},
+			},
+			responses: 1,
+		},
+		{
+			req: &testpb.StreamingOutputCallRequest{
+				ResponseType:       unsupportedPayloadType,
+				ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+			},
+		},
+	} {
+		stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(testCase.req))
+		require.NoError(t, err)
+		var respCnt int
+		for stream.Receive() {
+			respCnt++
+		}
+		assert.Equal(t, testCase.responses, respCnt)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+		require.NoError(t, stream.Close())
+	}
+	t.Successf("successful server streaming with invalid argument")
+}
+
+// DoFullDuplexWithInvalidArgument performs a full duplex RPC whose second
+// request asks for a response with a negative size, and one whose first request
+// asks for an unsupported payload type, and expects the server to fail both
+// streams as invalid arguments, after responding to the first request of the
+// former.
+func DoFullDuplexWithInvalidArgument(t crosstesting.TB, client connectpb.TestServiceClient) {
+	for _, testCase := range []struct {
+		reqs      []*testpb.StreamingOutputCallRequest
+		responses int
+	}{
+		{
+			reqs: []*testpb.StreamingOutputCallRequest{
+				{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseParameters: []*testpb.ResponseParameters{{Size: 1}}},
+				{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseParameters: []*testpb.ResponseParameters{{Size: -1}}},
+			},
+			responses: 1,
+		},
+		{
+			reqs: []*testpb.StreamingOutputCallRequest{
+				{ResponseType: unsupportedPayloadType, ResponseParameters: []*testpb.ResponseParameters{{Size: 1}}},
+			},
+		},
+	} {
+		stream := client.FullDuplexCall(context.Background())
+		var respCnt int
+		var err error
+		for _, req := range testCase.reqs {
+			require.NoError(t, stream.Send(req))
+			if _, err = stream.Receive(); err != nil {
+				break
+			}
+			respCnt++
+		}
+		assert.Equal(t, testCase.responses, respCnt)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "unexpected error: %v", err)
+		// the server has already ended the stream, so closing the request body
+		// may race with the end of the response over HTTP/2
+		_ = stream.CloseRequest()
+		_ = stream.CloseResponse()
+	}
+	t.Successf("successful full duplex with invalid argument")
+}
+
+// DoResponseStatusWithTrailingMetadata checks that the trailing metadata echoed
+// by the server is delivered along with the requested status of a unary call.
+func DoResponseStatusWithTrailingMetadata(t crosstesting.TB, client connectpb.TestServiceClient) {
+	msg := "test status message"
+	req := connect.NewRequest(&testpb.SimpleRequest{
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    int32(connect.CodeUnknown),
+			Message: msg,
+		},
+	})
+	withEchoMetadata(req.Header(), nil, [][]byte{[]byte(trailingMetadataValue)})
+	_, err := client.UnaryCall(context.Background(), req)
+	assert.Error(t, err)
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, connect.CodeUnknown, connectErr.Code())
+	assert.Equal(t, msg, connectErr.Message())
+	trailing, err := decodeBinaryValues(connectErr.Meta().Values(trailingMetadataKey))
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(trailingMetadataValue)}, trailing)
+	t.Successf("successful response status with trailing metadata")
+}
+
 // DoStatusCodeAndMessageFullDuplex checks that the status code is propagated back to the client with full duplex call.
 func DoStatusCodeAndMessageFullDuplex(t crosstesting.TB, client connectpb.TestServiceClient) {
 	code := int32(connect.CodeUnknown)
@@ -554,6 +2302,68 @@ func DoStatusCodeAndMessageFullDuplex(t crosstesting.TB, client connectpb.TestSe
 	t.Successf("successful code and message full duplex")
 }
 
+// DoStatusCodeAndMessageServerStreaming checks that the status code and a
+// message with whitespace and Unicode are propagated back to the client after
+// the preceding messages of a server streaming call.
+func DoStatusCodeAndMessageServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	code := int32(connect.CodeUnknown)
+	msg := "\t\ntest with whitespace\r\nand Unicode BMP ☺ and non-BMP 😈\t\n"
+	expectedErr := connect.NewError(connect.CodeUnknown, errors.New(msg)) // nolint:stylecheck // we do want to test the behaviour for error string that end with a newline
+	respParam := make([]*testpb.ResponseParameters, len(respSizes))
+	for i, s := range respSizes {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(s),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    code,
+			Message: msg,
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(req))
+	require.NoError(t, err)
+	var respCnt int
+	for stream.Receive() {
+		assert.Equal(t, len(stream.Msg().GetPayload().GetBody()), respSizes[respCnt])
+		respCnt++
+	}
+	err = stream.Err()
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeUnknown)
+	assert.Equal(t, err.Error(), expectedErr.Error())
+	require.NoError(t, stream.Close())
+	assert.Equal(t, respCnt, len(respSizes))
+	t.Successf("successful code and message server streaming")
+}
+
+// DoServerStreamingEarlyError performs a server streaming RPC asking for an
+// error status without any response, and expects the stream to fail with the
+// exact status before receiving any message.
+func DoServerStreamingEarlyError(t crosstesting.TB, client connectpb.TestServiceClient) {
+	const msg = "server streaming early error"
+	stream, err := client.StreamingOutputCall(context.Background(), connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    int32(connect.CodeFailedPrecondition),
+			Message: msg,
+		},
+	}))
+	require.NoError(t, err)
+	assert.False(t, stream.Receive(), "received a message before the error")
+	err = stream.Err()
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, msg, connectErr.Message())
+	require.NoError(t, stream.Close())
+	t.Successf("successful server streaming early error")
+}
+
 // DoSpecialStatusMessage verifies Unicode and whitespace is correctly processed
 // in status message.
 func DoSpecialStatusMessage(t crosstesting.TB, client connectpb.TestServiceClient) {
@@ -637,6 +2447,140 @@ func DoFailWithNonASCIIError(t crosstesting.TB, client connectpb.TestServiceClie
 	t.Successf("successful fail call with non-ASCII error")
 }
 
+// DoErrorWithDetails performs a unary RPC that always returns an error with
+// RetryInfo and QuotaFailure error details.
+func DoErrorWithDetails(t crosstesting.TB, client connectpb.TestServiceClient) {
+	reply, err := client.FailUnaryCallWithDetails(
+		context.Background(),
+		connect.NewRequest(
+			&testpb.SimpleRequest{
+				ResponseType: testpb.PayloadType_COMPRESSABLE,
+			},
+		),
+	)
+	assert.Nil(t, reply)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeResourceExhausted)
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, connectErr.Message(), interop.QuotaErrMsg)
+	require.Len(t, connectErr.Details(), 2)
+	var retryInfo errdetails.RetryInfo
+	require.NoError(t, connectErr.Details()[0].UnmarshalTo(&retryInfo))
+	assert.True(t, proto.Equal(&retryInfo, interop.RetryInfo))
+	var quotaFailure errdetails.QuotaFailure
+	require.NoError(t, connectErr.Details()[1].UnmarshalTo(&quotaFailure))
+	assert.True(t, proto.Equal(&quotaFailure, interop.QuotaFailure))
+	t.Successf("successful error with details")
+}
+
+// DoTrailersOnly performs a failing unary RPC with the gRPC protocol, and
+// inspects the raw HTTP response recorded by the transport of the client. The
+// response has no messages, so the gRPC specification calls for a
+// "trailers-only" response, where the status is sent in a single HEADERS frame
+// and the body is empty. net/http handlers can't control framing, so connect-go
+// servers send the status as HTTP trailers instead, and the test is only run
+// against servers for which SupportsTrailersOnly reports true.
+func DoTrailersOnly(t crosstesting.TB, client connectpb.TestServiceClient, transport *ResponseRecordingTransport) {
+	reply, err := client.FailUnaryCall(
+		context.Background(),
+		connect.NewRequest(
+			&testpb.SimpleRequest{
+				ResponseType: testpb.PayloadType_COMPRESSABLE,
+			},
+		),
+	)
+	assert.Nil(t, reply)
+	assert.Equal(t, connect.CodeOf(err), connect.CodeResourceExhausted)
+	response := transport.Response()
+	require.NotNil(t, response)
+	assert.Equal(t, response.Header.Get("Grpc-Status"), strconv.Itoa(int(connect.CodeResourceExhausted)))
+	assert.Empty(t, response.Trailer.Get("Grpc-Status"), "grpc-status sent in both headers and trailers")
+	assert.Equal(t, response.ContentLength, int64(0), "trailers-only response with a body")
+	assert.Empty(t, transport.ResponseBody())
+	t.Successf("successful trailers-only")
+}
+
+// DoConnectErrorJSON performs a FailUnaryCall with the Connect protocol, and
+// checks that the raw response is an error in the JSON shape of the protocol,
+// with the HTTP status of the code, so that Connect clients in other languages
+// can parse it.
+func DoConnectErrorJSON(t crosstesting.TB, client connectpb.TestServiceClient, transport *ResponseRecordingTransport) {
+	_, err := client.FailUnaryCall(
+		context.Background(),
+		connect.NewRequest(
+			&testpb.SimpleRequest{
+				ResponseType: testpb.PayloadType_COMPRESSABLE,
+			},
+		),
+	)
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	response := transport.Response()
+	require.NotNil(t, response)
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+	var wireErr struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Details []json.RawMessage `json:"details"`
+	}
+	body := transport.ResponseBody()
+	require.NoError(t, json.Unmarshal(body, &wireErr), "invalid error JSON: %q", body)
+	assert.Equal(t, "resource_exhausted", wireErr.Code)
+	assert.Equal(t, interop.NonASCIIErrMsg, wireErr.Message)
+	assert.Len(t, wireErr.Details, 1)
+	t.Successf("successful connect error JSON")
+}
+
+// DoConnectContentType performs unary RPCs with the Connect protocol, with the
+// binary Protobuf and the JSON codecs, and checks the raw Content-Type of the
+// requests and responses: application/proto and application/json. It then
+// posts a unary request with an unsupported Content-Type, which the server must
+// reject with the HTTP status 415, since it can't tell which protocol and codec
+// the request uses. The clients are created with the given transport and
+// options, so the options must not select another protocol.
+func DoConnectContentType(t crosstesting.TB, transport http.RoundTripper, baseURL string, options ...connect.ClientOption) {
+	for _, testCase := range []struct {
+		contentType string
+		options     []connect.ClientOption
+	}{
+		{contentType: "application/proto"},
+		{contentType: "application/json", options: []connect.ClientOption{connect.WithProtoJSON()}},
+	} {
+		recordingTransport := NewResponseRecordingTransport(transport)
+		client := connectpb.NewTestServiceClient(
+			&http.Client{Transport: recordingTransport},
+			baseURL,
+			connect.WithClientOptions(options...),
+			connect.WithClientOptions(testCase.options...),
+		)
+		_, err := client.UnaryCall(context.Background(), connect.NewRequest(&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: int32(1),
+		}))
+		require.NoError(t, err)
+		response := recordingTransport.Response()
+		require.NotNil(t, response)
+		assert.Equal(t, testCase.contentType, recordingTransport.Request().Header.Get("Content-Type"), "request Content-Type")
+		assert.Equal(t, testCase.contentType, response.Header.Get("Content-Type"), "response Content-Type")
+	}
+	request, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		strings.TrimSuffix(baseURL, "/")+UnaryCallProcedure,
+		strings.NewReader("unsupported"),
+	)
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "text/plain")
+	response, err := (&http.Client{Transport: transport}).Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	_, err = io.Copy(io.Discard, response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, response.StatusCode)
+	t.Successf("successful connect content type")
+}
+
 // DoFailServerStreamingWithNonASCIIError performs a server streaming RPC that always return a readable non-ASCII error.
 func DoFailServerStreamingWithNonASCIIError(t crosstesting.TB, client connectpb.TestServiceClient) {
 	respParam := make([]*testpb.ResponseParameters, len(respSizes))
@@ -667,6 +2611,44 @@ func DoFailServerStreamingWithNonASCIIError(t crosstesting.TB, client connectpb.
 	t.Successf("successful fail server streaming with non-ASCII error")
 }
 
+// DoFailServerStreaming performs a server streaming RPC that fails with a
+// non-ASCII error after sending two responses, which must be received before
+// the error.
+func DoFailServerStreaming(t crosstesting.TB, client connectpb.TestServiceClient) {
+	sizes := []int{31415, 9}
+	respParam := make([]*testpb.ResponseParameters, len(sizes))
+	for i, s := range sizes {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(s),
+		}
+	}
+	req := connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	})
+	req.Header().Set(interop.FailAfterResponsesKey, "true")
+	stream, err := client.FailStreamingOutputCall(context.Background(), req)
+	require.NoError(t, err)
+	var respCnt int
+	for stream.Receive() {
+		require.Less(t, respCnt, len(sizes), "received more than %d responses", len(sizes))
+		assert.Equal(t, sizes[respCnt], len(stream.Msg().GetPayload().GetBody()))
+		respCnt++
+	}
+	assert.Equal(t, len(sizes), respCnt)
+	err = stream.Err()
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, interop.NonASCIIErrMsg, connectErr.Message())
+	require.Len(t, connectErr.Details(), 1)
+	var errorDetail testpb.ErrorDetail
+	require.NoError(t, connectErr.Details()[0].UnmarshalTo(&errorDetail))
+	assert.True(t, proto.Equal(&errorDetail, interop.ErrorDetail))
+	require.NoError(t, stream.Close())
+	t.Successf("successful fail server streaming")
+}
+
 // DoUnresolvableHost attempts to call a method to an unresolvable host.
 func DoUnresolvableHost(t crosstesting.TB, client connectpb.TestServiceClient) {
 	reply, err := client.EmptyCall(
@@ -678,3 +2660,89 @@ func DoUnresolvableHost(t crosstesting.TB, client connectpb.TestServiceClient) {
 	assert.Equal(t, connect.CodeOf(err), connect.CodeUnavailable)
 	t.Successf("successful fail call with unresolvable call")
 }
+
+// DoRPCSoak performs the given number of large unary RPCs with the same client.
+// It fails if more than maxFailures of the RPCs fail, and reports the latency
+// percentiles of the RPCs otherwise.
+func DoRPCSoak(
+	t crosstesting.TB,
+	client connectpb.TestServiceClient,
+	iterations int,
+	maxFailures int,
+	perIterationTimeout time.Duration,
+) {
+	newClient := func() (connectpb.TestServiceClient, func()) {
+		return client, func() {}
+	}
+	doSoak(t, "rpc soak", newClient, iterations, maxFailures, perIterationTimeout)
+}
+
+// DoChannelSoak performs the given number of large unary RPCs, creating a new
+// client for each of them. The close function returned with each client is
+// called once its RPC completes. It fails if more than maxFailures of the RPCs
+// fail, and reports the latency percentiles of the RPCs otherwise.
+func DoChannelSoak(
+	t crosstesting.TB,
+	newClient func() (client connectpb.TestServiceClient, closeClient func()),
+	iterations int,
+	maxFailures int,
+	perIterationTimeout time.Duration,
+) {
+	doSoak(t, "channel soak", newClient, iterations, maxFailures, perIterationTimeout)
+}
+
+func doSoak(
+	t crosstesting.TB,
+	name string,
+	newClient func() (connectpb.TestServiceClient, func()),
+	iterations int,
+	maxFailures int,
+	perIterationTimeout time.Duration,
+) {
+	t.Helper()
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(largeRespSize),
+		Payload:      pl,
+	}
+	var failures int
+	latencies := make([]time.Duration, 0, iterations)
+	for i := 0; i < iterations; i++ {
+		client, closeClient := newClient()
+		ctx, cancel := context.WithTimeout(context.Background(), perIterationTimeout)
+		start := time.Now()
+		reply, err := client.UnaryCall(ctx, connect.NewRequest(req))
+		latencies = append(latencies, time.Since(start))
+		cancel()
+		closeClient()
+		if err != nil || len(reply.Msg.GetPayload().GetBody()) != largeRespSize {
+			failures++
+		}
+	}
+	if failures > maxFailures {
+		t.Errorf("%s failed %d out of %d iterations, exceeding the maximum of %d failures", name, failures, iterations, maxFailures)
+		t.FailNow()
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	t.Successf(
+		"successful %s with %d failures out of %d iterations, latency p50=%v p90=%v p99=%v",
+		name,
+		failures,
+		iterations,
+		latencyPercentile(latencies, 50),
+		latencyPercentile(latencies, 90),
+		latencyPercentile(latencies, 99),
+	)
+}
+
+// latencyPercentile returns the given percentile of the sorted latencies.
+func latencyPercentile(latencies []time.Duration, percentile int) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	return latencies[(len(latencies)-1)*percentile/100]
+}
@@ -19,33 +19,91 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
 	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
 	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-// NewTestServiceHandler returns a new TestServiceHandler.
-func NewTestServiceHandler() testingconnect.TestServiceHandler {
-	return &testServer{}
+// NewTestServiceHandler returns a new TestServiceHandler. Random payloads are
+// generated from the given seed.
+func NewTestServiceHandler(seed int64) testingconnect.TestServiceHandler {
+	return &testServer{
+		rand: interop.NewRand(seed),
+	}
 }
 
 type testServer struct {
 	testingconnect.UnimplementedTestServiceHandler
+
+	rand *interop.Rand
 }
 
 func (s *testServer) EmptyCall(ctx context.Context, request *connect.Request[testpb.Empty]) (*connect.Response[testpb.Empty], error) {
-	return connect.NewResponse(new(testpb.Empty)), nil
+	response := connect.NewResponse(new(testpb.Empty))
+	if err := echoMetadata(request.Header(), response.Header(), response.Trailer()); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *testServer) UnaryCall(ctx context.Context, request *connect.Request[testpb.SimpleRequest]) (*connect.Response[testpb.SimpleResponse], error) {
+	// measure the time remaining until the deadline first, so that it's as
+	// close as possible to the timeout sent by the client
+	deadline, hasDeadline := ctx.Deadline()
+	remaining := time.Until(deadline)
+	if request.Msg.GetExpectCompressed().GetValue() && !isCompressed(request.Header()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expected compressed request, but got uncompressed request"))
+	}
+	// ResponseCompressed isn't honored: connect-go negotiates the response
+	// compression from the compression accepted by the client before the
+	// handler runs, and has no option to opt a single response out of it
+	if failAttempts := request.Header().Get(interop.FailAttemptsKey); failAttempts != "" {
+		attempt, fail, err := interop.ParseAttempt(failAttempts, request.Header().Get(interop.PreviousAttemptsKey))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if fail {
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New(interop.FailedAttemptErrMsg(attempt)))
+		}
+	}
+	if sleep := request.Header().Get(interop.UnarySleepKey); sleep != "" {
+		duration, err := time.ParseDuration(sleep)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// stop sleeping as soon as the deadline is exceeded, logging it so that
+		// the deadline can be observed on the server, and check the context
+		// again in case the deadline expired as the sleep ended
+		if err := interop.Sleep(ctx, duration); err != nil {
+			log.Printf("UnaryCall stopped sleeping: %v", err)
+			return nil, err
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
 	if status := request.Msg.GetResponseStatus(); status != nil && status.Code != 0 {
-		return nil, connect.NewError(connect.Code(status.Code), errors.New(status.Message))
+		err := connect.NewError(connect.Code(status.Code), errors.New(status.Message))
+		// trailing metadata accompanies error statuses in gRPC, so it's echoed to
+		// the metadata of the error
+		_, trailing, metadataErr := echoMetadataFrom(request.Header())
+		if metadataErr != nil {
+			return nil, metadataErr
+		}
+		for _, value := range trailing {
+			err.Meta().Add(trailingMetadataKey, connect.EncodeBinaryHeader(value))
+		}
+		return nil, err
 	}
-	payload, err := newServerPayload(request.Msg.GetResponseType(), request.Msg.GetResponseSize())
+	payload, err := s.newServerPayload(request.Msg.GetResponseType(), request.Msg.GetResponseSize())
 	if err != nil {
 		return nil, err
 	}
@@ -54,20 +112,52 @@ func (s *testServer) UnaryCall(ctx context.Context, request *connect.Request[tes
 			Payload: payload,
 		},
 	)
-	if leadingMetadata := request.Header().Values(leadingMetadataKey); len(leadingMetadata) != 0 {
-		for _, value := range leadingMetadata {
-			response.Header().Add(leadingMetadataKey, value)
+	if err := echoMetadata(request.Header(), response.Header(), response.Trailer()); err != nil {
+		return nil, err
+	}
+	if hasDeadline && request.Header().Get(echoDeadlineKey) != "" {
+		response.Header().Set(echoDeadlineKey, remaining.String())
+	}
+	if request.Header().Get(echoUserAgentKey) != "" {
+		response.Header().Set(echoUserAgentKey, request.Header().Get("User-Agent"))
+	}
+	if request.Header().Get(echoRequestSizeKey) != "" {
+		response.Header().Set(echoRequestSizeKey, strconv.Itoa(len(request.Msg.GetPayload().GetBody())))
+	}
+	if request.Header().Get(echoPeerKey) != "" {
+		// connect-go doesn't expose the peer, so it's recorded by NewPeerHandler
+		peer, ok := peerFromContext(ctx)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("unknown peer, the handler isn't wrapped with NewPeerHandler"))
 		}
+		response.Header().Set(echoPeerKey, peer)
 	}
-	if trailingMetadata := request.Header().Values(trailingMetadataKey); len(trailingMetadata) != 0 {
-		for _, value := range trailingMetadata {
-			decodedTrailingMetadata, err := connect.DecodeBinaryHeader(value)
-			if err != nil {
-				return nil, err
-			}
-			response.Trailer().Add(trailingMetadataKey, connect.EncodeBinaryHeader(decodedTrailingMetadata))
+	if orcaReport := request.Msg.GetOrcaPerQueryReport(); orcaReport != nil {
+		loadReport, err := proto.Marshal(interop.NewOrcaLoadReport(orcaReport))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+		response.Trailer().Set(interop.OrcaLoadReportKey, connect.EncodeBinaryHeader(loadReport))
 	}
+	if count := request.Header().Get(interop.TrailerCountKey); count != "" {
+		trailers, err := interop.ParseTrailers(count, request.Header().Get(interop.TrailerSizeKey))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		for key, value := range trailers {
+			response.Trailer().Set(key, value)
+		}
+	}
+	return response, nil
+}
+
+func (s *testServer) CacheableUnaryCall(ctx context.Context, request *connect.Request[testpb.SimpleRequest]) (*connect.Response[testpb.SimpleResponse], error) {
+	response := connect.NewResponse(
+		&testpb.SimpleResponse{
+			Payload: interop.NewCacheablePayload(),
+		},
+	)
+	response.Header().Set("Cache-Control", interop.CacheControl)
 	return response, nil
 }
 
@@ -81,45 +171,80 @@ func (s *testServer) FailUnaryCall(ctx context.Context, request *connect.Request
 	return nil, err
 }
 
-func (s *testServer) StreamingOutputCall(ctx context.Context, request *connect.Request[testpb.StreamingOutputCallRequest], stream *connect.ServerStream[testpb.StreamingOutputCallResponse]) error {
-	if leadingMetadata := request.Header().Values(leadingMetadataKey); len(leadingMetadata) != 0 {
-		for _, value := range leadingMetadata {
-			stream.ResponseHeader().Add(leadingMetadataKey, value)
+func (s *testServer) FailUnaryCallWithDetails(ctx context.Context, request *connect.Request[testpb.SimpleRequest]) (*connect.Response[testpb.SimpleResponse], error) {
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New(interop.QuotaErrMsg))
+	for _, msg := range []proto.Message{interop.RetryInfo, interop.QuotaFailure} {
+		detail, anyErr := anypb.New(msg)
+		if anyErr != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("error when creating error details"))
 		}
+		err.AddDetail(detail)
 	}
-	if trailingMetadata := request.Header().Values(trailingMetadataKey); len(trailingMetadata) != 0 {
-		for _, value := range trailingMetadata {
-			decodedTrailingMetadata, err := connect.DecodeBinaryHeader(value)
-			if err != nil {
-				return err
-			}
-			stream.ResponseTrailer().Add(trailingMetadataKey, connect.EncodeBinaryHeader(decodedTrailingMetadata))
-		}
+	return nil, err
+}
+
+func (s *testServer) StreamingOutputCall(ctx context.Context, request *connect.Request[testpb.StreamingOutputCallRequest], stream *connect.ServerStream[testpb.StreamingOutputCallResponse]) error {
+	if err := echoMetadata(request.Header(), stream.ResponseHeader(), stream.ResponseTrailer()); err != nil {
+		return err
 	}
-	for _, param := range request.Msg.GetResponseParameters() {
-		if us := param.GetIntervalUs(); us > 0 {
-			time.Sleep(time.Duration(us) * time.Microsecond)
-		}
-		// Checking if the context is canceled or deadline exceeded, in a real world usage it will
-		// make more sense to put this checking before the expensive works (i.e. the time.Sleep above),
-		// but in order to simulate a network latency issue, we put the context checking here.
-		if err := ctx.Err(); err != nil {
+	var statusErr error
+	if status := request.Msg.GetResponseStatus(); status != nil && status.Code != 0 {
+		statusErr = connect.NewError(connect.Code(status.Code), errors.New(status.Message))
+	}
+	// fail right away when there's no response to send, since failing before the
+	// first message frames differently than failing mid-stream
+	if statusErr != nil && len(request.Msg.GetResponseParameters()) == 0 {
+		return statusErr
+	}
+	sequence := request.Header().Get(interop.SequencePayloadKey) != ""
+	// the Compressed parameters aren't honored: connect-go compresses either all
+	// or none of the messages in a stream, and has no option to opt a single
+	// message out of it
+	for i, param := range request.Msg.GetResponseParameters() {
+		// stop waiting as soon as the client cancels or the deadline is exceeded,
+		// logging it so that the cancellation can be observed on the server
+		if err := interop.Sleep(ctx, time.Duration(param.GetIntervalUs())*time.Microsecond); err != nil {
+			log.Printf("StreamingOutputCall stopped after %d responses: %v", i, err)
 			return err
 		}
-		payload, err := newServerPayload(request.Msg.GetResponseType(), param.GetSize())
+		payload, err := s.newServerPayload(request.Msg.GetResponseType(), param.GetSize())
 		if err != nil {
 			return err
 		}
+		if sequence {
+			if err := interop.PutSequence(payload.Body, i); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+		// generating a large payload takes a while, so check the context again
+		// before sending it
+		if err := ctx.Err(); err != nil {
+			log.Printf("StreamingOutputCall stopped after %d responses: %v", i, err)
+			return err
+		}
 		if err := stream.Send(&testpb.StreamingOutputCallResponse{
 			Payload: payload,
 		}); err != nil {
 			return err
 		}
 	}
-	return nil
+	return statusErr
 }
 
 func (s *testServer) FailStreamingOutputCall(ctx context.Context, request *connect.Request[testpb.StreamingOutputCallRequest], stream *connect.ServerStream[testpb.StreamingOutputCallResponse]) error {
+	if request.Header().Get(interop.FailAfterResponsesKey) != "" {
+		for _, param := range request.Msg.GetResponseParameters() {
+			payload, err := s.newServerPayload(request.Msg.GetResponseType(), param.GetSize())
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&testpb.StreamingOutputCallResponse{
+				Payload: payload,
+			}); err != nil {
+				return err
+			}
+		}
+	}
 	err := connect.NewError(connect.CodeResourceExhausted, errors.New(interop.NonASCIIErrMsg))
 	detail, anyErr := anypb.New(interop.ErrorDetail)
 	if anyErr != nil {
@@ -135,7 +260,13 @@ func (s *testServer) StreamingInputCall(ctx context.Context, stream *connect.Cli
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
+		if stream.Msg().GetExpectCompressed().GetValue() && !isCompressed(stream.RequestHeader()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expected compressed request, but got uncompressed request"))
+		}
 		p := stream.Msg().GetPayload().GetBody()
+		if string(p) == interop.AbortClientStreamBody {
+			return nil, connect.NewError(connect.CodeAborted, errors.New(interop.AbortClientStreamErrMsg))
+		}
 		sum += len(p)
 	}
 	if err := stream.Err(); err != nil {
@@ -149,18 +280,17 @@ func (s *testServer) StreamingInputCall(ctx context.Context, stream *connect.Cli
 }
 
 func (s *testServer) FullDuplexCall(ctx context.Context, stream *connect.BidiStream[testpb.StreamingOutputCallRequest, testpb.StreamingOutputCallResponse]) error {
-	if leadingMetadata := stream.RequestHeader().Values(leadingMetadataKey); len(leadingMetadata) != 0 {
-		for _, value := range leadingMetadata {
-			stream.ResponseHeader().Add(leadingMetadataKey, value)
-		}
+	if err := echoMetadata(stream.RequestHeader(), stream.ResponseHeader(), stream.ResponseTrailer()); err != nil {
+		return err
 	}
-	if trailingMetadata := stream.RequestHeader().Values(trailingMetadataKey); len(trailingMetadata) != 0 {
-		for _, value := range trailingMetadata {
-			decodedTrailingMetadata, err := connect.DecodeBinaryHeader(value)
-			if err != nil {
-				return err
-			}
-			stream.ResponseTrailer().Add(trailingMetadataKey, connect.EncodeBinaryHeader(decodedTrailingMetadata))
+	if delay := stream.RequestHeader().Get(interop.FirstResponseDelayKey); delay != "" {
+		duration, err := time.ParseDuration(delay)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// the headers set above are only sent with the first response
+		if err := interop.Sleep(ctx, duration); err != nil {
+			return err
 		}
 	}
 	for {
@@ -183,7 +313,7 @@ func (s *testServer) FullDuplexCall(ctx context.Context, stream *connect.BidiStr
 			if us := c.GetIntervalUs(); us > 0 {
 				time.Sleep(time.Duration(us) * time.Microsecond)
 			}
-			payload, err := newServerPayload(request.GetResponseType(), c.GetSize())
+			payload, err := s.newServerPayload(request.GetResponseType(), c.GetSize())
 			if err != nil {
 				return err
 			}
@@ -218,7 +348,7 @@ func (s *testServer) HalfDuplexCall(ctx context.Context, stream *connect.BidiStr
 			if us := c.GetIntervalUs(); us > 0 {
 				time.Sleep(time.Duration(us) * time.Microsecond)
 			}
-			payload, err := newServerPayload(msg.GetResponseType(), c.GetSize())
+			payload, err := s.newServerPayload(msg.GetResponseType(), c.GetSize())
 			if err != nil {
 				return err
 			}
@@ -232,15 +362,36 @@ func (s *testServer) HalfDuplexCall(ctx context.Context, stream *connect.BidiStr
 	return nil
 }
 
-func newServerPayload(payloadType testpb.PayloadType, size int32) (*testpb.Payload, error) {
+// isCompressed reports whether the request messages were compressed, based on
+// the encoding header of the protocol used by the client.
+func isCompressed(header http.Header) bool {
+	for _, key := range []string{"Grpc-Encoding", "Content-Encoding", "Connect-Content-Encoding"} {
+		if encoding := header.Get(key); encoding != "" && encoding != "identity" {
+			return true
+		}
+	}
+	return false
+}
+
+// newServerPayload returns a payload of the given type and size. Its errors are
+// invalid argument errors, which the handlers return as they are.
+func (s *testServer) newServerPayload(payloadType testpb.PayloadType, size int32) (*testpb.Payload, error) {
 	if size < 0 {
-		return nil, fmt.Errorf("requested a response with invalid length %d", size)
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested a response with invalid length %d", size))
+	}
+	if payloadType == testpb.PayloadType_RANDOM {
+		payloadType = []testpb.PayloadType{
+			testpb.PayloadType_COMPRESSABLE,
+			testpb.PayloadType_UNCOMPRESSABLE,
+		}[s.rand.Intn(2)]
 	}
 	body := make([]byte, size)
 	switch payloadType {
 	case testpb.PayloadType_COMPRESSABLE:
+	case testpb.PayloadType_UNCOMPRESSABLE:
+		s.rand.Read(body)
 	default:
-		return nil, fmt.Errorf("unsupported payload type: %d", payloadType)
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported payload type: %d", payloadType))
 	}
 	return &testpb.Payload{
 		Type: payloadType,
@@ -0,0 +1,250 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-crosstest/internal/gen/proto/connect/grpc/testing/testingconnect"
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStreamingOutputCallCanceled(t *testing.T) {
+	t.Parallel()
+	checkLeakedGoroutines(t)
+	handler := &streamingOutputCallRecorder{
+		TestServiceHandler: NewTestServiceHandler(0),
+		returned:           make(chan error, 1),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(handler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := testingconnect.NewTestServiceClient(server.Client(), server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamingOutputCall(ctx, connect.NewRequest(&testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{
+			{Size: 1},
+			// the handler would wait for a minute without the context
+			{Size: 1, IntervalUs: int32(time.Minute.Microseconds())},
+		},
+	}))
+	require.NoError(t, err)
+	require.True(t, stream.Receive(), stream.Err())
+	cancel()
+	select {
+	case err := <-handler.returned:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("StreamingOutputCall didn't return after the client canceled")
+	}
+}
+
+func TestFullDuplexCallGracefulShutdown(t *testing.T) {
+	t.Parallel()
+	checkLeakedGoroutines(t)
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(NewTestServiceHandler(0)))
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+	client := testingconnect.NewTestServiceClient(server.Client(), server.URL, connect.WithGRPC())
+	stream := client.FullDuplexCall(context.Background())
+	pingPong := func() {
+		t.Helper()
+		require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+			ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+		}))
+		response, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Len(t, response.GetPayload().GetBody(), 1)
+	}
+	pingPong()
+	shutdown := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdown <- server.Config.Shutdown(ctx)
+	}()
+	// the listener is closed as soon as the shutdown starts, so new connections
+	// are refused
+	assert.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", server.Listener.Addr().String())
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+	// the in-flight stream isn't dropped, and the shutdown waits for it
+	select {
+	case err := <-shutdown:
+		t.Fatalf("shutdown returned before the in-flight stream completed: %v", err)
+	default:
+	}
+	pingPong()
+	require.NoError(t, stream.CloseRequest())
+	_, err := stream.Receive()
+	assert.True(t, errors.Is(err, io.EOF), err)
+	require.NoError(t, stream.CloseResponse())
+	select {
+	case err := <-shutdown:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown didn't return after the in-flight stream completed")
+	}
+}
+
+// maxUnaryCallAllocs is the maximum number of allocations of a unary call,
+// counting both the client and the server. It's about twice the number of
+// allocations measured with connect-go v0.2.0, so that it only catches large
+// regressions.
+const maxUnaryCallAllocs = 300
+
+func TestUnaryCallAllocs(t *testing.T) {
+	// not parallel, since the allocations of other tests would be counted too
+	checkLeakedGoroutines(t)
+	mux := http.NewServeMux()
+	mux.Handle(testingconnect.NewTestServiceHandler(NewTestServiceHandler(0)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := testingconnect.NewTestServiceClient(server.Client(), server.URL)
+	request := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: 16,
+		Payload: &testpb.Payload{
+			Type: testpb.PayloadType_COMPRESSABLE,
+			Body: make([]byte, 16),
+		},
+	}
+	var err error
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, callErr := client.UnaryCall(context.Background(), connect.NewRequest(request)); callErr != nil {
+			err = callErr
+		}
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, allocs, float64(maxUnaryCallAllocs), "allocations per unary call")
+}
+
+// maxFuzzResponseSize bounds the size of the responses requested by the fuzz
+// target, since the server allocates whatever size it's asked for.
+const maxFuzzResponseSize = 1 << 20
+
+func FuzzUnaryCall(f *testing.F) {
+	for _, request := range []*testpb.SimpleRequest{
+		{},
+		{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseSize: 16,
+			Payload: &testpb.Payload{
+				Type: testpb.PayloadType_COMPRESSABLE,
+				Body: make([]byte, 16),
+			},
+		},
+		{
+			ResponseType:   testpb.PayloadType_UNCOMPRESSABLE,
+			ResponseSize:   1024,
+			FillUsername:   true,
+			FillOauthScope: true,
+		},
+		{
+			ResponseStatus: &testpb.EchoStatus{
+				Code:    int32(connect.CodeUnknown),
+				Message: "test status message",
+			},
+		},
+		{ExpectCompressed: &testpb.BoolValue{Value: true}},
+		{ResponseSize: -1},
+	} {
+		data, err := proto.Marshal(request)
+		require.NoError(f, err)
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		request := new(testpb.SimpleRequest)
+		// the raw bytes are sent, so that the server also decodes invalid messages,
+		// but the response size of valid ones is still bounded
+		isRequest := proto.Unmarshal(data, request) == nil
+		if isRequest && request.GetResponseSize() > maxFuzzResponseSize {
+			t.Skipf("response size %d is too large", request.GetResponseSize())
+		}
+		// a server is started for each input, so that panics in the handler fail
+		// the input that caused them
+		httpClient, url := newInProcessServer(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url+UnaryCallProcedure, bytes.NewReader(data))
+		require.NoError(t, err)
+		httpRequest.Header.Set("Content-Type", "application/proto")
+		httpResponse, err := httpClient.Do(httpRequest)
+		require.NoError(t, err)
+		defer httpResponse.Body.Close()
+		body, err := io.ReadAll(httpResponse.Body)
+		require.NoError(t, err)
+		if httpResponse.StatusCode == http.StatusOK {
+			require.True(t, isRequest, "invalid request succeeded")
+			assert.Equal(t, "application/proto", httpResponse.Header.Get("Content-Type"))
+			response := new(testpb.SimpleResponse)
+			require.NoError(t, proto.Unmarshal(body, response))
+			assert.Len(t, response.GetPayload().GetBody(), int(request.GetResponseSize()))
+			return
+		}
+		// Connect errors are sent as JSON, with a code and an optional message
+		assert.Equal(t, "application/json", httpResponse.Header.Get("Content-Type"))
+		var connectErr struct {
+			Code    connect.Code `json:"code"`
+			Message string       `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal(body, &connectErr), "error body %q", body)
+		require.NotZero(t, connectErr.Code, "error body %q", body)
+		if code := request.GetResponseStatus().GetCode(); isRequest && code != 0 {
+			assert.Equal(t, connect.Code(code), connectErr.Code)
+		} else {
+			assert.NotEqual(t, connect.CodeDeadlineExceeded, connectErr.Code)
+		}
+	})
+}
+
+// streamingOutputCallRecorder records the error StreamingOutputCall returns.
+type streamingOutputCallRecorder struct {
+	testingconnect.TestServiceHandler
+
+	returned chan error
+}
+
+func (r *streamingOutputCallRecorder) StreamingOutputCall(
+	ctx context.Context,
+	request *connect.Request[testpb.StreamingOutputCallRequest],
+	stream *connect.ServerStream[testpb.StreamingOutputCallResponse],
+) error {
+	err := r.TestServiceHandler.StreamingOutputCall(ctx, request, stream)
+	r.returned <- err
+	return err
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// SupportsTrailersOnly probes whether the server at the base URL sends
+// trailers-only responses with the gRPC protocol, by calling FailUnaryCall
+// with an empty request and checking that the status is sent in the headers.
+// Servers that can't control the framing of responses, such as connect-go
+// servers, send the status as HTTP trailers instead.
+func SupportsTrailersOnly(ctx context.Context, httpClient connect.HTTPClient, baseURL string) (bool, error) {
+	// an empty message is sent as an envelope with no flags and a zero length
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/grpc.testing.TestService/FailUnaryCall", bytes.NewReader(make([]byte, 5)))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/grpc")
+	request.Header.Set("Te", "trailers")
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if _, err := io.Copy(io.Discard, response.Body); err != nil {
+		return false, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected HTTP status %d probing for trailers-only support", response.StatusCode)
+	}
+	return response.Header.Get("Grpc-Status") != "", nil
+}
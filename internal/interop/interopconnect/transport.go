@@ -0,0 +1,234 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopconnect
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bufbuild/connect-crosstest/internal/compression"
+)
+
+// ConnCountingTransport is an http.RoundTripper that counts the new
+// connections used by the transport it wraps. Connections are observed with
+// httptrace, so the wrapped transport must report GotConn events.
+type ConnCountingTransport struct {
+	transport http.RoundTripper
+	conns     int64
+}
+
+// NewConnCountingTransport returns a ConnCountingTransport wrapping the given
+// transport.
+func NewConnCountingTransport(transport http.RoundTripper) *ConnCountingTransport {
+	return &ConnCountingTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ConnCountingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if !info.Reused {
+				atomic.AddInt64(&t.conns, 1)
+			}
+		},
+	}
+	return t.transport.RoundTrip(request.WithContext(httptrace.WithClientTrace(request.Context(), trace)))
+}
+
+// Conns returns the number of new connections used so far.
+func (t *ConnCountingTransport) Conns() int64 {
+	return atomic.LoadInt64(&t.conns)
+}
+
+// withConnectionStateTrace returns a context recording the TLS connection state
+// of the connection a request made with it gets. Connections are observed with
+// httptrace, so the state is left unset by transports that don't report GotConn
+// events, and for connections without TLS.
+func withConnectionStateTrace(ctx context.Context, state *tls.ConnectionState) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if conn, ok := info.Conn.(*tls.Conn); ok {
+				*state = conn.ConnectionState()
+			}
+		},
+	})
+}
+
+// RequestBytesCountingTransport is an http.RoundTripper that counts the bytes
+// of the request bodies sent by the transport it wraps, which are the
+// compressed messages of compressed requests.
+type RequestBytesCountingTransport struct {
+	transport http.RoundTripper
+	bytes     int64
+}
+
+// NewRequestBytesCountingTransport returns a RequestBytesCountingTransport
+// wrapping the given transport.
+func NewRequestBytesCountingTransport(transport http.RoundTripper) *RequestBytesCountingTransport {
+	return &RequestBytesCountingTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RequestBytesCountingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		request = request.Clone(request.Context())
+		request.Body = &countingReadCloser{ReadCloser: request.Body, count: &t.bytes}
+	}
+	return t.transport.RoundTrip(request)
+}
+
+// RequestBytes returns the number of bytes of the request bodies read by the
+// wrapped transport so far.
+func (t *RequestBytesCountingTransport) RequestBytes() int64 {
+	return atomic.LoadInt64(&t.bytes)
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	count *int64
+}
+
+func (r *countingReadCloser) Read(data []byte) (int, error) {
+	n, err := r.ReadCloser.Read(data)
+	atomic.AddInt64(r.count, int64(n))
+	return n, err
+}
+
+// ResponseRecordingTransport is an http.RoundTripper that records the last
+// response returned by the transport it wraps, and the request it answered, so
+// that tests can inspect the raw HTTP headers, body and trailers.
+type ResponseRecordingTransport struct {
+	transport http.RoundTripper
+	mu        sync.Mutex
+	request   *http.Request
+	response  *http.Response
+	body      *bytes.Buffer
+}
+
+// NewResponseRecordingTransport returns a ResponseRecordingTransport wrapping
+// the given transport.
+func NewResponseRecordingTransport(transport http.RoundTripper) *ResponseRecordingTransport {
+	return &ResponseRecordingTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ResponseRecordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	body := &bytes.Buffer{}
+	response.Body = &teeReadCloser{
+		Reader: io.TeeReader(response.Body, body),
+		Closer: response.Body,
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.request = request
+	t.response = response
+	t.body = body
+	return response, nil
+}
+
+// Request returns the request of the last response recorded. Not all
+// transports set the request of their responses, so it's recorded separately.
+func (t *ResponseRecordingTransport) Request() *http.Request {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.request
+}
+
+// Response returns the last response recorded. Its trailers are only populated
+// once the response body has been read.
+func (t *ResponseRecordingTransport) Response() *http.Response {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.response
+}
+
+// ResponseBody returns the bytes read so far from the body of the last
+// response recorded.
+func (t *ResponseRecordingTransport) ResponseBody() []byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.body == nil {
+		return nil
+	}
+	return t.body.Bytes()
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// CompressionMismatchTransport is an http.RoundTripper that claims requests
+// are gzip compressed, while leaving their bodies uncompressed. For the gRPC
+// and gRPC-Web protocols, it also sets the compressed flag of the first
+// message's envelope, since messages without the flag aren't decompressed.
+type CompressionMismatchTransport struct {
+	transport http.RoundTripper
+}
+
+// NewCompressionMismatchTransport returns a CompressionMismatchTransport
+// wrapping the given transport.
+func NewCompressionMismatchTransport(transport http.RoundTripper) *CompressionMismatchTransport {
+	return &CompressionMismatchTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CompressionMismatchTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	if strings.HasPrefix(request.Header.Get("Content-Type"), "application/grpc") {
+		request.Header.Set("Grpc-Encoding", compression.Gzip)
+		if request.Body != nil {
+			request.Body = &compressedFlagReader{ReadCloser: request.Body}
+		}
+	} else {
+		request.Header.Set("Content-Encoding", compression.Gzip)
+	}
+	return t.transport.RoundTrip(request)
+}
+
+// compressedFlagReader sets the compressed flag in the first byte read, which
+// is the flags byte of the first envelope.
+type compressedFlagReader struct {
+	io.ReadCloser
+	flagged bool
+}
+
+func (r *compressedFlagReader) Read(data []byte) (int, error) {
+	n, err := r.ReadCloser.Read(data)
+	if n > 0 && !r.flagged {
+		data[0] |= 1
+		r.flagged = true
+	}
+	return n, err
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopgrpc
+
+import (
+	"context"
+
+	healthv1 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/health/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewHealthServer creates a health server, reporting the server and the given
+// services as serving. Checking any other service fails with the status
+// NOT_FOUND.
+func NewHealthServer(services ...string) healthv1.HealthServer {
+	statuses := map[string]healthv1.HealthCheckResponse_ServingStatus{
+		// the empty service is the health of the server as a whole
+		"": healthv1.HealthCheckResponse_SERVING,
+	}
+	for _, service := range services {
+		statuses[service] = healthv1.HealthCheckResponse_SERVING
+	}
+	return &healthServer{
+		statuses: statuses,
+	}
+}
+
+type healthServer struct {
+	healthv1.UnimplementedHealthServer
+
+	statuses map[string]healthv1.HealthCheckResponse_ServingStatus
+}
+
+func (s *healthServer) Check(ctx context.Context, request *healthv1.HealthCheckRequest) (*healthv1.HealthCheckResponse, error) {
+	servingStatus, ok := s.statuses[request.GetService()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", request.GetService())
+	}
+	return &healthv1.HealthCheckResponse{Status: servingStatus}, nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interopgrpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	"github.com/bufbuild/connect-crosstest/internal/interop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SizeLoggingUnaryClientInterceptor is a grpc.UnaryClientInterceptor that logs
+// the sizes of the request and response messages of unary calls.
+func SizeLoggingUnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	request, response any,
+	clientConn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, request, response, clientConn, opts...)
+	log.Printf(
+		"SIZE:  %s request %d bytes, response %d bytes",
+		method,
+		messageSize(request),
+		messageSize(response),
+	)
+	return err
+}
+
+// NewTimeoutInterceptors returns client interceptors that give the unary and
+// streaming calls without a deadline the given timeout, so that a call to a
+// hung server fails with the status DEADLINE_EXCEEDED, and with it only the
+// test case making it. The calls with a deadline keep it, since test cases set
+// them on purpose.
+func NewTimeoutInterceptors(timeout time.Duration) (grpc.UnaryClientInterceptor, grpc.StreamClientInterceptor) {
+	unary := func(
+		ctx context.Context,
+		method string,
+		request, response any,
+		clientConn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); ok {
+			return invoker(ctx, method, request, response, clientConn, opts...)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return invoker(ctx, method, request, response, clientConn, opts...)
+	}
+	stream := func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		clientConn *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return streamer(ctx, desc, clientConn, method, opts...)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		clientStream, err := streamer(ctx, desc, clientConn, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &timeoutClientStream{ClientStream: clientStream, cancel: cancel}, nil
+	}
+	return unary, stream
+}
+
+// timeoutClientStream releases the timeout of the stream once it ends, which
+// RecvMsg reports with an error.
+type timeoutClientStream struct {
+	grpc.ClientStream
+
+	cancel context.CancelFunc
+}
+
+func (s *timeoutClientStream) RecvMsg(message any) error {
+	err := s.ClientStream.RecvMsg(message)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
+
+// NewBearerTokenCredentials returns per-RPC credentials that attach the token
+// to the authorization header of each request.
+func NewBearerTokenCredentials(token string) credentials.PerRPCCredentials {
+	return bearerTokenCredentials(token)
+}
+
+type bearerTokenCredentials string
+
+func (c bearerTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{interop.AuthorizationKey: interop.BearerToken(string(c))}, nil
+}
+
+func (c bearerTokenCredentials) RequireTransportSecurity() bool {
+	// the token is only a test fixture, so it's sent over h2c too
+	return false
+}
+
+// NewVersionInterceptors returns server interceptors that set the version
+// header to the given version on the responses of both unary and streaming
+// RPCs, including the failed ones. Like the connect-go interceptor, failed
+// unary RPCs carry it in their trailers rather than their headers, so that
+// grpc-go can still send them as trailers-only responses. The header of unary
+// RPCs is set once the handler returns, so unary handlers must set their
+// headers rather than send them.
+func NewVersionInterceptors(version string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		response, err := handler(ctx, request)
+		if err != nil {
+			if trailerErr := grpc.SetTrailer(ctx, metadata.Pairs(interop.VersionKey, version)); trailerErr != nil {
+				return nil, trailerErr
+			}
+			return nil, err
+		}
+		if err := grpc.SetHeader(ctx, metadata.Pairs(interop.VersionKey, version)); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+	stream := func(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := stream.SetHeader(metadata.Pairs(interop.VersionKey, version)); err != nil {
+			return err
+		}
+		return handler(server, stream)
+	}
+	return unary, stream
+}
+
+// NewAuthInterceptors returns server interceptors that reject requests to
+// the test service without the bearer token with the status UNAUTHENTICATED.
+// The other services, like health checking, don't require the token.
+func NewAuthInterceptors(token string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := checkBearerToken(ctx, info.FullMethod, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, request)
+	}
+	stream := func(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkBearerToken(stream.Context(), info.FullMethod, token); err != nil {
+			return err
+		}
+		return handler(server, stream)
+	}
+	return unary, stream
+}
+
+func checkBearerToken(ctx context.Context, method, token string) error {
+	if !strings.HasPrefix(method, "/"+testpb.TestService_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(interop.AuthorizationKey); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	if err := interop.CheckBearerToken(authorization, token); err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil
+}
+
+func messageSize(message any) int {
+	if message, ok := message.(proto.Message); ok {
+		return proto.Size(message)
+	}
+	return 0
+}
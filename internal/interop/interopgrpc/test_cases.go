@@ -39,20 +39,31 @@ package interopgrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bufbuild/connect-crosstest/internal/crosstesting"
+	healthv1 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/health/v1"
 	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
+	orcav3 "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/xds/data/orca/v3"
 	"github.com/bufbuild/connect-crosstest/internal/interop"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -60,19 +71,29 @@ const (
 	sixteenBytes        = 16
 	oneKiB              = 1024
 	twoKiB              = 2028
+	eightKiB            = 8192
+	sixteenKiB          = 16384
 	thirtyTwoKiB        = 32768
 	sixtyFourKiB        = 65536
 	twoFiftyKiB         = 256000
 	fiveHundredKiB      = 512000
+	oneMiB              = 1048576
 	largeReqSize        = twoFiftyKiB
 	largeRespSize       = fiveHundredKiB
 	leadingMetadataKey  = "x-grpc-test-echo-initial"
 	trailingMetadataKey = "x-grpc-test-echo-trailing-bin"
+	echoDeadlineKey     = "x-grpc-test-echo-deadline"
+	echoUserAgentKey    = "x-grpc-test-echo-user-agent"
+	echoRequestSizeKey  = "x-grpc-test-echo-request-size"
+	echoPeerKey         = "x-grpc-test-echo-peer"
 )
 
 var (
 	reqSizes  = []int{twoFiftyKiB, eightBytes, oneKiB, thirtyTwoKiB}      // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
 	respSizes = []int{fiveHundredKiB, sixteenBytes, twoKiB, sixtyFourKiB} // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
+	// sizes of the metadata values of the oversized metadata test, to find
+	// where the header size limits of the implementations kick in
+	oversizedMetadataSizes = []int{eightKiB, sixteenKiB, sixtyFourKiB} // nolint:gochecknoglobals
 )
 
 // clientNewPayload returns a payload of the given type and size.
@@ -97,6 +118,67 @@ func DoEmptyUnaryCall(t crosstesting.TB, client testpb.TestServiceClient, args .
 	t.Successf("successful unary call")
 }
 
+// DoEmptyUnaryCallWithHeaders performs an empty unary RPC with metadata to
+// echo, and checks that the metadata is echoed back even though the response
+// message is empty.
+func DoEmptyUnaryCallWithHeaders(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx := metadata.NewOutgoingContext(context.Background(), customMetadata)
+	var header, trailer metadata.MD
+	reply, err := client.EmptyCall(ctx, &testpb.Empty{}, append(args, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Empty{}, reply))
+	assert.Equal(t, []string{leadingMetadataValue}, header.Get(leadingMetadataKey))
+	assert.Equal(t, []string{trailingMetadataValue}, trailer.Get(trailingMetadataKey))
+	t.Successf("successful empty unary call with headers")
+}
+
+// DoServerVersion checks that the server stamps the responses of both unary
+// and streaming calls with its version, to identify the server build a run
+// hit.
+func DoServerVersion(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	var header metadata.MD
+	_, err := client.EmptyCall(context.Background(), &testpb.Empty{}, append(args, grpc.Header(&header))...)
+	require.NoError(t, err)
+	version := header.Get(interop.VersionKey)
+	require.Len(t, version, 1, "missing %s header on unary response", interop.VersionKey)
+	assert.NotEmpty(t, version[0], "empty %s header on unary response", interop.VersionKey)
+	stream, err := client.StreamingOutputCall(
+		context.Background(),
+		&testpb.StreamingOutputCallRequest{
+			ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+		},
+		args...,
+	)
+	require.NoError(t, err)
+	for {
+		if _, err := stream.Recv(); err != nil {
+			require.Equal(t, io.EOF, err)
+			break
+		}
+	}
+	streamHeader, err := stream.Header()
+	require.NoError(t, err)
+	assert.Len(t, streamHeader.Get(interop.VersionKey), 1, "missing %s header on streaming response", interop.VersionKey)
+	t.Successf("successful server version %s", version[0])
+}
+
+// DoUnaryWithCompressedEmptyMessage performs unary RPCs with a request that
+// marshals to zero bytes, compressed with gzip, so that the server must handle
+// a compressed frame carrying no data. UnaryCall must respond with an empty
+// payload, and EmptyCall with an empty message.
+func DoUnaryWithCompressedEmptyMessage(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	args = append(args, grpc.UseCompressor(gzip.Name))
+	req := &testpb.SimpleRequest{}
+	require.Zero(t, proto.Size(req))
+	reply, err := client.UnaryCall(context.Background(), req, args...)
+	require.NoError(t, err)
+	assert.Empty(t, reply.GetPayload().GetBody())
+	emptyReply, err := client.EmptyCall(context.Background(), &testpb.Empty{}, args...)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Empty{}, emptyReply))
+	t.Successf("successful unary with compressed empty message")
+}
+
 // DoLargeUnaryCall performs a unary RPC with large payload in the request and response.
 func DoLargeUnaryCall(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeReqSize)
@@ -113,6 +195,28 @@ func DoLargeUnaryCall(t crosstesting.TB, client testpb.TestServiceClient, args .
 	t.Successf("successful large unary call")
 }
 
+// DoLargeUnaryCallBidirectionalSizes performs a unary RPC with a large
+// request and a large response, so that the request is read and the response
+// is written with large frames in the same call. The client asks the server to
+// echo the size of the request payload it received.
+func DoLargeUnaryCallBidirectionalSizes(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, oneMiB)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(oneMiB),
+		Payload:      pl,
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), echoRequestSizeKey, "true")
+	var header metadata.MD
+	reply, err := client.UnaryCall(ctx, req, append(args, grpc.Header(&header))...)
+	require.NoError(t, err)
+	assert.Equal(t, reply.GetPayload().GetType(), testpb.PayloadType_COMPRESSABLE)
+	assert.Equal(t, len(reply.GetPayload().GetBody()), oneMiB)
+	assert.Equal(t, []string{strconv.Itoa(oneMiB)}, header.Get(echoRequestSizeKey))
+	t.Successf("successful large unary call with bidirectional sizes")
+}
+
 // DoClientStreaming performs a client streaming RPC.
 func DoClientStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	stream, err := client.StreamingInputCall(context.Background(), args...)
@@ -133,12 +237,129 @@ func DoClientStreaming(t crosstesting.TB, client testpb.TestServiceClient, args
 	t.Successf("successful client streaming test")
 }
 
-// DoServerStreaming performs a server streaming RPC.
-func DoServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+const (
+	largeAggregateMessages    = 1000
+	largeAggregateMessageSize = 1024
+	largeAggregateTimeout     = 10 * time.Second
+)
+
+// DoStreamingInputCallLargeAggregate sends 1000 requests of 1KiB on a client
+// stream, and checks that the server aggregates all of them. The deadline of
+// the call is generous for a local server but fails a stalled stream.
+func DoStreamingInputCallLargeAggregate(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx, cancel := context.WithTimeout(context.Background(), largeAggregateTimeout)
+	defer cancel()
+	start := time.Now()
+	stream, err := client.StreamingInputCall(ctx, args...)
+	require.NoError(t, err)
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, largeAggregateMessageSize)
+	require.NoError(t, err)
+	for i := 0; i < largeAggregateMessages; i++ {
+		require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}), "failed to send request %d", i)
+	}
+	reply, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	elapsed := time.Since(start)
+	assert.Equal(t, int32(largeAggregateMessages*largeAggregateMessageSize), reply.GetAggregatedPayloadSize())
+	t.Successf("successful streaming input call large aggregate in %v", elapsed)
+}
+
+// DoGracefulStreamHalfClose performs a client streaming RPC, half-closes the
+// stream with CloseAndRecv, and then checks that sending on the closed stream
+// errors rather than panics.
+func DoGracefulStreamHalfClose(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	stream, err := client.StreamingInputCall(context.Background(), args...)
+	require.NoError(t, err)
+	var sum int
+	for _, size := range reqSizes {
+		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, size)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}))
+		sum += size
+	}
+	reply, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	assert.Equal(t, int32(sum), reply.GetAggregatedPayloadSize())
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, reqSizes[0])
+	require.NoError(t, err)
+	assert.NotPanics(t, func() {
+		err = stream.Send(&testpb.StreamingInputCallRequest{Payload: pl})
+	})
+	assert.Error(t, err, "send after CloseAndRecv")
+	t.Successf("successful graceful stream half close")
+}
+
+// DoClientStreamingWithServerError performs a client streaming RPC whose
+// first request asks the server to fail the call right away, and keeps
+// sending requests. The sends must start failing once the call has failed,
+// well before the client is done sending, without hanging, and the client
+// must receive the server's error from CloseAndRecv.
+func DoClientStreamingWithServerError(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	const maxSends = 1000
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamingInputCall(ctx, args...)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{
+		Payload: &testpb.Payload{
+			Type: testpb.PayloadType_COMPRESSABLE,
+			Body: []byte(interop.AbortClientStreamBody),
+		},
+	}))
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, thirtyTwoKiB)
+	require.NoError(t, err)
+	var sends int
+	for sends < maxSends && stream.Send(&testpb.StreamingInputCallRequest{Payload: pl}) == nil {
+		sends++
+	}
+	assert.Less(t, sends, maxSends, "sends kept succeeding after the server failed the call")
+	_, err = stream.CloseAndRecv()
+	require.Error(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Equal(t, interop.AbortClientStreamErrMsg, status.Convert(err).Message())
+	t.Successf("successful client streaming with server error after %d sends", sends)
+}
+
+// DoStreamingInputCallEmptyPayload performs client streaming RPCs where
+// requests with a nil or empty payload are interspersed with real payloads, and
+// where many empty requests are followed by a single payload. The aggregated
+// payload size must only count the bodies of the real payloads.
+func DoStreamingInputCallEmptyPayload(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	var interspersed []*testpb.Payload
+	for _, size := range reqSizes {
+		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, size)
+		require.NoError(t, err)
+		interspersed = append(interspersed, nil, pl, &testpb.Payload{})
+	}
+	trailing := make([]*testpb.Payload, 100, 101)
+	pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, oneKiB)
+	require.NoError(t, err)
+	trailing = append(trailing, pl)
+	for _, payloads := range [][]*testpb.Payload{interspersed, trailing} {
+		stream, err := client.StreamingInputCall(context.Background(), args...)
+		require.NoError(t, err)
+		var sum int
+		for _, payload := range payloads {
+			require.NoError(t, stream.Send(&testpb.StreamingInputCallRequest{
+				Payload: payload,
+			}))
+			sum += len(payload.GetBody())
+		}
+		reply, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		assert.Equal(t, int32(sum), reply.GetAggregatedPayloadSize())
+	}
+	t.Successf("successful client streaming with empty payloads")
+}
+
+// DoServerStreaming performs a server streaming RPC, asking the server to wait
+// for the message delay before sending each response.
+func DoServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, messageDelay time.Duration, args ...grpc.CallOption) {
 	respParam := make([]*testpb.ResponseParameters, len(respSizes))
 	for i, s := range respSizes {
 		respParam[i] = &testpb.ResponseParameters{
-			Size: int32(s),
+			Size:       int32(s),
+			IntervalUs: int32(messageDelay.Microseconds()),
 		}
 	}
 	req := &testpb.StreamingOutputCallRequest{
@@ -166,15 +387,17 @@ func DoServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args
 	t.Successf("successful server streaming test")
 }
 
-// DoPingPong performs ping-pong style bi-directional streaming RPC.
-func DoPingPong(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+// DoPingPong performs ping-pong style bi-directional streaming RPC, asking the
+// server to wait for the message delay before sending each response.
+func DoPingPong(t crosstesting.TB, client testpb.TestServiceClient, messageDelay time.Duration, args ...grpc.CallOption) {
 	stream, err := client.FullDuplexCall(context.Background(), args...)
 	require.NoError(t, err)
 	var index int
 	for index < len(reqSizes) {
 		respParam := []*testpb.ResponseParameters{
 			{
-				Size: int32(respSizes[index]),
+				Size:       int32(respSizes[index]),
+				IntervalUs: int32(messageDelay.Microseconds()),
 			},
 		}
 		pl, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, reqSizes[index])
@@ -197,6 +420,171 @@ func DoPingPong(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc
 	t.Successf("successful ping pong")
 }
 
+const (
+	interleavedMessages = 50
+	interleavedInterval = time.Millisecond
+)
+
+// DoInterleavedBidiStreaming sends 50 requests on a full duplex stream before
+// reading any response, with the server waiting briefly before echoing each
+// one. Each request asks for a response of a size unique to its index, and the
+// responses must come back in the order of the requests.
+func DoInterleavedBidiStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	stream, err := client.FullDuplexCall(context.Background(), args...)
+	require.NoError(t, err)
+	for i := 0; i < interleavedMessages; i++ {
+		require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+			ResponseParameters: []*testpb.ResponseParameters{
+				{
+					Size:       int32(i + 1),
+					IntervalUs: int32(interleavedInterval.Microseconds()),
+				},
+			},
+		}), "failed to send request %d", i)
+	}
+	for i := 0; i < interleavedMessages; i++ {
+		reply, err := stream.Recv()
+		require.NoError(t, err, "failed to receive response %d", i)
+		assert.Equal(t, i+1, len(reply.GetPayload().GetBody()), "response %d is out of order", i)
+	}
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	t.Successf("successful interleaved bidi streaming")
+}
+
+// grpc-go raises keepalive ping intervals below 10 seconds to 10 seconds.
+const minKeepaliveInterval = 10 * time.Second
+
+// DoKeepaliveIdleConnection performs a unary RPC, idles for five keepalive
+// ping intervals, then expects a second unary RPC to succeed. It then does the
+// same within a full duplex stream. Servers enforcing a minimum ping interval,
+// like grpc-go's, send a GOAWAY and close the connection when pinged too often:
+// the unary RPC must then succeed on a new connection, while the stream must
+// fail with the status UNAVAILABLE.
+func DoKeepaliveIdleConnection(t crosstesting.TB, client testpb.TestServiceClient, interval time.Duration, args ...grpc.CallOption) {
+	if interval < minKeepaliveInterval {
+		interval = minKeepaliveInterval
+	}
+	idle := 5 * interval
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+	}
+	_, err := client.UnaryCall(context.Background(), req, args...)
+	require.NoError(t, err)
+	time.Sleep(idle)
+	_, err = client.UnaryCall(context.Background(), req, args...)
+	require.NoError(t, err, "unary call after idling for %v", idle)
+	stream, err := client.FullDuplexCall(context.Background(), args...)
+	require.NoError(t, err)
+	streamReq := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size: int32(1),
+			},
+		},
+	}
+	require.NoError(t, stream.Send(streamReq))
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	time.Sleep(idle)
+	// Send returns io.EOF if the server closed the connection, the error is
+	// then returned by Recv
+	if err := stream.Send(streamReq); err != nil {
+		assert.True(t, errors.Is(err, io.EOF), "send after idling for %v: %v", idle, err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		assert.Equal(t, codes.Unavailable, status.Code(err), "receive after idling for %v: %v", idle, err)
+	}
+	assert.NoError(t, stream.CloseSend())
+	t.Successf("successful keepalive idle connection")
+}
+
+const (
+	concurrentStreams        = 256
+	concurrentStreamMessages = 4
+)
+
+// DoManyConcurrentStreams opens many full duplex streams concurrently on the
+// same client, and exchanges a few messages on each. Each response must have
+// the size requested on its own stream, so that responses mixed up between
+// streams are detected.
+func DoManyConcurrentStreams(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	// a deadlocked stream fails with the deadline instead of hanging the test
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errs := make(chan error, concurrentStreams)
+	for i := 0; i < concurrentStreams; i++ {
+		index := i
+		go func() {
+			errs <- concurrentStreamPingPong(ctx, client, index, args...)
+		}()
+	}
+	for i := 0; i < concurrentStreams; i++ {
+		assert.NoError(t, <-errs)
+	}
+	t.Successf("successful many concurrent streams")
+}
+
+func concurrentStreamPingPong(ctx context.Context, client testpb.TestServiceClient, index int, args ...grpc.CallOption) error {
+	stream, err := client.FullDuplexCall(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("stream %d: %w", index, err)
+	}
+	for i := 0; i < concurrentStreamMessages; i++ {
+		size := index*concurrentStreamMessages + i + 1
+		if err := stream.Send(&testpb.StreamingOutputCallRequest{
+			ResponseType:       testpb.PayloadType_COMPRESSABLE,
+			ResponseParameters: []*testpb.ResponseParameters{{Size: int32(size)}},
+		}); err != nil {
+			return fmt.Errorf("stream %d: send %d: %w", index, i, err)
+		}
+		reply, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("stream %d: receive %d: %w", index, i, err)
+		}
+		if received := len(reply.GetPayload().GetBody()); received != size {
+			return fmt.Errorf("stream %d: response %d has a payload of %d bytes, expected %d", index, i, received, size)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return fmt.Errorf("stream %d: close send: %w", index, err)
+	}
+	if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("stream %d: expected the end of the stream, got %v", index, err)
+	}
+	return nil
+}
+
+// DoMaxConcurrentStreams opens four times as many full duplex streams
+// concurrently as the server's limit of concurrent streams per connection, and
+// exchanges a few messages on each. None of the streams may fail: grpc-go
+// queues the streams over the limit until others complete.
+func DoMaxConcurrentStreams(t crosstesting.TB, client testpb.TestServiceClient, maxStreams int, args ...grpc.CallOption) {
+	streams := 4 * maxStreams
+	// a first call makes sure that the client received the server's limit
+	// before opening the streams
+	_, err := client.EmptyCall(context.Background(), &testpb.Empty{}, args...)
+	require.NoError(t, err)
+	// a deadlocked stream fails with the deadline instead of hanging the test
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errs := make(chan error, streams)
+	for i := 0; i < streams; i++ {
+		index := i
+		go func() {
+			errs <- concurrentStreamPingPong(ctx, client, index, args...)
+		}()
+	}
+	for i := 0; i < streams; i++ {
+		assert.NoError(t, <-errs)
+	}
+	t.Successf("successful max concurrent streams")
+}
+
 // DoEmptyStream sets up a bi-directional streaming with zero message.
 func DoEmptyStream(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	stream, err := client.FullDuplexCall(context.Background(), args...)
@@ -208,6 +596,30 @@ func DoEmptyStream(t crosstesting.TB, client testpb.TestServiceClient, args ...g
 	t.Successf("successful empty stream")
 }
 
+// DoEmptyStreamClientStreaming performs a client streaming RPC without sending
+// any requests, and expects an aggregated payload size of zero.
+func DoEmptyStreamClientStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	stream, err := client.StreamingInputCall(context.Background(), args...)
+	require.NoError(t, err)
+	reply, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	assert.Equal(t, reply.GetAggregatedPayloadSize(), int32(0))
+	t.Successf("successful empty stream client streaming")
+}
+
+// DoEmptyStreamServerStreaming performs a server streaming RPC without any
+// response parameters, and expects the stream to end without any responses.
+func DoEmptyStreamServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+	}
+	stream, err := client.StreamingOutputCall(context.Background(), req, args...)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, err, io.EOF)
+	t.Successf("successful empty stream server streaming")
+}
+
 // DoTimeoutOnSleepingServer performs an RPC on a sleep server which causes RPC timeout.
 func DoTimeoutOnSleepingServer(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
@@ -240,6 +652,46 @@ var testMetadata = metadata.MD{ // nolint:gochecknoglobals // We do want to make
 	"key2": []string{"value2"},
 }
 
+// DoDeadlinePropagation performs unary RPCs with deadlines and checks that the
+// time remaining until the deadline observed by the server is within two
+// seconds of the timeout sent by the client. Timeouts of hours check that large
+// timeouts are serialized without overflowing the timeout header, which for
+// gRPC has at most 8 digits, so they are truncated to seconds.
+func DoDeadlinePropagation(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for _, timeout := range []time.Duration{10 * time.Second, 5 * time.Hour, 90 * time.Hour} {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx = metadata.AppendToOutgoingContext(ctx, echoDeadlineKey, "true")
+		var header metadata.MD
+		_, err := client.UnaryCall(ctx, &testpb.SimpleRequest{}, append(args, grpc.Header(&header))...)
+		cancel()
+		require.NoError(t, err)
+		echoed := header.Get(echoDeadlineKey)
+		require.Equal(t, len(echoed), 1)
+		remaining, err := time.ParseDuration(echoed[0])
+		require.NoError(t, err)
+		assert.True(t, remaining <= timeout, "server observed %v remaining, over the %v timeout", remaining, timeout)
+		assert.True(t, remaining > timeout-2*time.Second, "server observed %v remaining, for a %v timeout", remaining, timeout)
+	}
+	// A sub-millisecond deadline may expire before the server responds.
+	timeout := 500 * time.Microsecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, echoDeadlineKey, "true")
+	var header metadata.MD
+	_, err := client.UnaryCall(ctx, &testpb.SimpleRequest{}, append(args, grpc.Header(&header))...)
+	if err != nil {
+		assert.Equal(t, status.Code(err), codes.DeadlineExceeded)
+		t.Successf("successful deadline propagation")
+		return
+	}
+	echoed := header.Get(echoDeadlineKey)
+	require.Equal(t, len(echoed), 1, "server observed no deadline")
+	remaining, err := time.ParseDuration(echoed[0])
+	require.NoError(t, err)
+	assert.True(t, remaining <= timeout, "server observed %v remaining, over the %v timeout", remaining, timeout)
+	t.Successf("successful deadline propagation")
+}
+
 // DoCancelAfterBegin cancels the RPC after metadata has been sent but before payloads are sent.
 func DoCancelAfterBegin(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(context.Background(), testMetadata))
@@ -251,6 +703,97 @@ func DoCancelAfterBegin(t crosstesting.TB, client testpb.TestServiceClient, args
 	t.Successf("successful cancel after begin")
 }
 
+// DoCancelAfterBeginServerStreaming cancels a server streaming RPC after the
+// request has been sent but before any response is received.
+func DoCancelAfterBeginServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size:       31415,
+				IntervalUs: int32((1 * time.Second).Microseconds()),
+			},
+		},
+	}
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, status.Code(err), codes.Canceled)
+	t.Successf("successful cancel after begin server streaming")
+}
+
+// DoCancelDuringServerStreaming cancels a server streaming RPC after receiving
+// some of the responses, while the server waits to send the next one.
+func DoCancelDuringServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	const (
+		responses = 10
+		received  = 3
+		interval  = 100 * time.Millisecond
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	respParam := make([]*testpb.ResponseParameters, responses)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size:       31415,
+			IntervalUs: int32(interval.Microseconds()),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	for i := 0; i < received; i++ {
+		resp, err := stream.Recv()
+		require.NoError(t, err, "failed to receive response %d", i)
+		assert.Equal(t, 31415, len(resp.GetPayload().GetBody()))
+	}
+	start := time.Now()
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	// the stream ends without waiting for the next response
+	assert.Less(t, time.Since(start), interval)
+	t.Successf("successful cancel during server streaming")
+}
+
+// DoCancelAfterFirstResponseServerStreaming cancels a server streaming RPC
+// after receiving exactly one response, while the server waits to send the
+// next one.
+func DoCancelAfterFirstResponseServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	const interval = time.Second
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{
+			{
+				Size: 31415,
+			},
+			{
+				Size:       31415,
+				IntervalUs: int32(interval.Microseconds()),
+			},
+		},
+	}
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err, "failed to receive the first response")
+	assert.Equal(t, 31415, len(resp.GetPayload().GetBody()))
+	start := time.Now()
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	// the stream ends without waiting for the second response
+	assert.Less(t, time.Since(start), interval)
+	t.Successf("successful cancel after first response server streaming")
+}
+
 // DoCancelAfterFirstResponse cancels the RPC after receiving the first message from the server.
 func DoCancelAfterFirstResponse(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -277,6 +820,48 @@ func DoCancelAfterFirstResponse(t crosstesting.TB, client testpb.TestServiceClie
 	t.Successf("successful cancel after first response")
 }
 
+const firstResponseDelay = 200 * time.Millisecond
+
+// DoRaceHeadersAndBody asks the server to set the response headers of a full
+// duplex call and wait before sending the first response. Servers that flush
+// the headers as soon as they're set, like grpc-go, mark them with the
+// interop.HeadersFlushedKey header, and the headers must then be available
+// before the delay elapses. connect-go servers can't flush the headers before
+// the first response, so otherwise the headers must arrive with it.
+func DoRaceHeadersAndBody(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		leadingMetadataKey, leadingMetadataValue,
+		interop.FirstResponseDelayKey, firstResponseDelay.String(),
+	)
+	start := time.Now()
+	stream, err := client.FullDuplexCall(ctx, args...)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+	}))
+	// Header blocks until the headers are received
+	header, err := stream.Header()
+	require.NoError(t, err)
+	headerElapsed := time.Since(start)
+	assert.Equal(t, []string{leadingMetadataValue}, header.Get(leadingMetadataKey))
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	receiveElapsed := time.Since(start)
+	assert.GreaterOrEqual(t, receiveElapsed, firstResponseDelay, "first response received before the delay elapsed")
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	if len(header.Get(interop.HeadersFlushedKey)) > 0 {
+		assert.Less(t, headerElapsed, firstResponseDelay, "flushed headers received after the delay")
+		t.Successf("successful race headers and body, with the headers flushed before the first response")
+		return
+	}
+	assert.GreaterOrEqual(t, headerElapsed, firstResponseDelay, "headers received before the first response")
+	t.Successf("successful race headers and body, with the headers sent with the first response")
+}
+
 const (
 	leadingMetadataValue  = "test_initial_metadata_value"
 	trailingMetadataValue = "\x0a\x0b\x0a\x0b\x0a\x0b"
@@ -293,6 +878,16 @@ var (
 		leadingMetadataKey, leadingMetadataValue+",more_stuff",
 		trailingMetadataKey, trailingMetadataValue+"\x0a",
 	)
+	// the values of the repeated metadata tests are distinct, so that their
+	// order can be checked
+	repeatedCustomMetadata = metadata.Pairs( // nolint:gochecknoglobals // We do want to make this a global so that we can use it in multiple methods
+		leadingMetadataKey, leadingMetadataValue+"-1",
+		trailingMetadataKey, trailingMetadataValue+"\x01",
+		leadingMetadataKey, leadingMetadataValue+"-2",
+		trailingMetadataKey, trailingMetadataValue+"\x02",
+		leadingMetadataKey, leadingMetadataValue+"-3",
+		trailingMetadataKey, trailingMetadataValue+"\x03",
+	)
 )
 
 func validateMetadata(t crosstesting.TB, header, trailer, sent metadata.MD) {
@@ -326,6 +921,147 @@ func DoCustomMetadata(t crosstesting.TB, client testpb.TestServiceClient, args .
 	t.Successf("successful custom metadata")
 }
 
+// DoLargeMetadataBinaryTrailer performs a unary RPC with a binary trailing
+// metadata value of about 4 KiB, a serialized payload containing all 256 byte
+// values, and expects the exact bytes to be echoed back.
+func DoLargeMetadataBinaryTrailer(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	value, err := interop.NewLargeBinaryTrailer()
+	require.NoError(t, err)
+	customMetadataUnaryTest(t, client, metadata.Pairs(trailingMetadataKey, string(value)), args...)
+	t.Successf("successful large metadata binary trailer")
+}
+
+// DoRepeatedMetadata adds the same metadata keys several times, with distinct
+// values, and checks that all the values are echoed back in order, unlike
+// DoDuplicatedCustomMetadata, which ignores the order.
+func DoRepeatedMetadata(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx := metadata.NewOutgoingContext(context.Background(), repeatedCustomMetadata)
+	var header, trailer metadata.MD
+	_, err := client.UnaryCall(ctx, &testpb.SimpleRequest{}, append(args, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	require.NoError(t, err)
+	validateRepeatedMetadata(t, header, trailer)
+	t.Successf("successful repeated metadata")
+}
+
+// unsupportedPayloadType is a payload type the test servers don't support.
+const unsupportedPayloadType = testpb.PayloadType(-1)
+
+const (
+	headerOnlyMetadataValue  = "header-only-value"
+	trailerOnlyMetadataValue = "trailer-only-value"
+)
+
+// DoUnaryWithResponseHeaderAndTrailerEcho asks the server to echo distinct
+// initial and trailing metadata on a successful unary call, and checks that
+// each arrives in its own place: the initial value in the header only, and the
+// trailing value in the trailer only, under any key.
+func DoUnaryWithResponseHeaderAndTrailerEcho(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(
+		leadingMetadataKey, headerOnlyMetadataValue,
+		trailingMetadataKey, trailerOnlyMetadataValue,
+	))
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+	}
+	var header, trailer metadata.MD
+	_, err := client.UnaryCall(ctx, req, append(args, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{headerOnlyMetadataValue}, header.Get(leadingMetadataKey))
+	assert.Equal(t, []string{trailerOnlyMetadataValue}, trailer.Get(trailingMetadataKey))
+	assert.Empty(t, header.Get(trailingMetadataKey))
+	assert.Empty(t, trailer.Get(leadingMetadataKey))
+	for key, values := range header {
+		assert.NotContains(t, values, trailerOnlyMetadataValue, "header %s", key)
+	}
+	for key, values := range trailer {
+		assert.NotContains(t, values, headerOnlyMetadataValue, "trailer %s", key)
+	}
+	t.Successf("successful unary with response header and trailer echo")
+}
+
+const (
+	manyTrailersCount    = 100
+	manyTrailersLongSize = oneKiB
+)
+
+// DoManyTrailers asks the server to attach 100 distinct trailers with short
+// values to a unary response, and checks that all of them are received without
+// truncation.
+func DoManyTrailers(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	manyTrailersTest(t, client, 0, args...)
+	t.Successf("successful many trailers")
+}
+
+// DoManyLongTrailers is the same as DoManyTrailers with values of 1 KiB.
+func DoManyLongTrailers(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	manyTrailersTest(t, client, manyTrailersLongSize, args...)
+	t.Successf("successful many long trailers")
+}
+
+func manyTrailersTest(t crosstesting.TB, client testpb.TestServiceClient, size int, args ...grpc.CallOption) {
+	t.Helper()
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		interop.TrailerCountKey, strconv.Itoa(manyTrailersCount),
+		interop.TrailerSizeKey, strconv.Itoa(size),
+	)
+	var trailer metadata.MD
+	_, err := client.UnaryCall(ctx, &testpb.SimpleRequest{}, append(args, grpc.Trailer(&trailer))...)
+	require.NoError(t, err)
+	for key, value := range interop.NewTrailers(manyTrailersCount, size) {
+		assert.Equal(t, []string{value}, trailer.Get(key), "trailer %s", key)
+	}
+}
+
+// DoRepeatedMetadataFullDuplex is the same as DoRepeatedMetadata with full
+// duplex call.
+func DoRepeatedMetadataFullDuplex(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx := metadata.NewOutgoingContext(context.Background(), repeatedCustomMetadata)
+	stream, err := client.FullDuplexCall(ctx, args...)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+	}))
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	header, err := stream.Header()
+	require.NoError(t, err)
+	validateRepeatedMetadata(t, header, stream.Trailer())
+	t.Successf("successful repeated metadata full duplex")
+}
+
+func validateRepeatedMetadata(t crosstesting.TB, header, trailer metadata.MD) {
+	assert.Equal(t, repeatedCustomMetadata.Get(leadingMetadataKey), header.Get(leadingMetadataKey))
+	assert.Equal(t, repeatedCustomMetadata.Get(trailingMetadataKey), trailer.Get(trailingMetadataKey))
+}
+
+// DoRequestResponseWithMetadataInTrailerOnly checks that trailing metadata
+// echoed without any initial metadata by a successful unary call is delivered
+// in the trailers only, and doesn't leak into the headers.
+func DoRequestResponseWithMetadataInTrailerOnly(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	payload, err := clientNewPayload(t, testpb.PayloadType_COMPRESSABLE, 1)
+	require.NoError(t, err)
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+		Payload:      payload,
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), trailingMetadataKey, trailingMetadataValue)
+	var header, trailer metadata.MD
+	reply, err := client.UnaryCall(ctx, req, append(args, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	require.NoError(t, err)
+	assert.Equal(t, len(reply.GetPayload().GetBody()), 1)
+	assert.Empty(t, header.Get(leadingMetadataKey))
+	assert.Empty(t, header.Get(trailingMetadataKey))
+	assert.Empty(t, trailer.Get(leadingMetadataKey))
+	assert.Equal(t, []string{trailingMetadataValue}, trailer.Get(trailingMetadataKey))
+	t.Successf("successful request response with metadata in trailer only")
+}
+
 // DoDuplicateCustomMetadata adds duplicated metadata keys and checks that the metadata is echoed back
 // to the client.
 func DoDuplicatedCustomMetadata(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
@@ -333,6 +1069,290 @@ func DoDuplicatedCustomMetadata(t crosstesting.TB, client testpb.TestServiceClie
 	t.Successf("successful duplicated custom metadata")
 }
 
+// DoPerRPCCredentials performs a unary RPC with a client attaching the bearer
+// token expected by the server, then expects the same RPC to fail with the
+// status UNAUTHENTICATED without a token and with an invalid one.
+func DoPerRPCCredentials(t crosstesting.TB, client, unauthenticatedClient testpb.TestServiceClient, token string, args ...grpc.CallOption) {
+	req := &testpb.SimpleRequest{
+		ResponseType: testpb.PayloadType_COMPRESSABLE,
+		ResponseSize: int32(1),
+	}
+	_, err := client.UnaryCall(context.Background(), req, args...)
+	require.NoError(t, err)
+	_, err = unauthenticatedClient.UnaryCall(context.Background(), req, args...)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = unauthenticatedClient.UnaryCall(
+		context.Background(),
+		req,
+		append(args, grpc.PerRPCCredentials(NewBearerTokenCredentials(token+"-invalid")))...,
+	)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	t.Successf("successful per rpc credentials")
+}
+
+// DoCacheableUnaryCall performs a CacheableUnaryCall, and checks that the
+// response has the cache control header letting a caching proxy serve
+// subsequent requests.
+func DoCacheableUnaryCall(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	var header metadata.MD
+	reply, err := client.CacheableUnaryCall(
+		context.Background(),
+		&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+		},
+		append(args, grpc.Header(&header))...,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{interop.CacheControl}, header.Get("cache-control"))
+	assert.NotEmpty(t, reply.GetPayload().GetBody())
+	t.Successf("successful cacheable unary call")
+}
+
+// DoMaxConnectionAge performs unary RPCs continuously from a few goroutines for
+// three times the maximum connection age of the server, which closes the
+// connections as they get older with a GOAWAY. None of the RPCs may fail, since
+// the client must move to a new connection transparently.
+func DoMaxConnectionAge(t crosstesting.TB, client testpb.TestServiceClient, maxAge time.Duration, args ...grpc.CallOption) {
+	const workers = 4
+	deadline := time.Now().Add(3 * maxAge)
+	var calls, failures int64
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				atomic.AddInt64(&calls, 1)
+				if _, err := client.EmptyCall(context.Background(), &testpb.Empty{}, args...); err != nil {
+					if atomic.AddInt64(&failures, 1) == 1 {
+						t.Errorf("first failed call: %v", err)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Zero(t, failures, "%d of %d calls failed", failures, calls)
+	t.Successf("successful max connection age")
+}
+
+// DoServerStreamingLargeMessageCount performs a server streaming RPC of 10,000
+// small messages, each with its index embedded in its payload, and checks that
+// they're all received in order, without gaps nor duplicates.
+func DoServerStreamingLargeMessageCount(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	const messageCount = 10000
+	respParam := make([]*testpb.ResponseParameters, messageCount)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(eightBytes),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), interop.SequencePayloadKey, "true")
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	var received, gaps, duplicates, next int
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			assert.Equal(t, io.EOF, err)
+			break
+		}
+		received++
+		sequence, err := interop.Sequence(reply.GetPayload().GetBody())
+		require.NoError(t, err)
+		switch {
+		case sequence < next:
+			duplicates++
+		case sequence > next:
+			gaps += sequence - next
+			next = sequence + 1
+		default:
+			next++
+		}
+	}
+	assert.Equal(t, messageCount, received)
+	assert.Zero(t, gaps, "messages missing from the sequence")
+	assert.Zero(t, duplicates, "messages duplicated or out of order")
+	t.Successf("successful server streaming with large message count")
+}
+
+const floodMessages = 2000
+
+// floodSizes are the sizes the responses of the zero interval flood test cycle
+// through, from the smallest payload with a sequence to frames larger than the
+// common read buffer sizes.
+var floodSizes = []int{eightBytes, 100, oneKiB - 1, 4*oneKiB + 1} // nolint:gochecknoglobals
+
+// DoServerStreamingZeroIntervalFlood performs a server streaming RPC of 2000
+// responses sent back to back, with an interval of zero, so that the client
+// reads frames as fast as the server writes them. Each response has its index
+// embedded in its payload, and must be received in order and with its own size,
+// so that frames dropped, split or coalesced are caught.
+func DoServerStreamingZeroIntervalFlood(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	respParam := make([]*testpb.ResponseParameters, floodMessages)
+	for i := range respParam {
+		respParam[i] = &testpb.ResponseParameters{
+			Size:       int32(floodSizes[i%len(floodSizes)]),
+			IntervalUs: 0,
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), interop.SequencePayloadKey, "true")
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	var received, mismatched int
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			assert.Equal(t, io.EOF, err)
+			break
+		}
+		body := reply.GetPayload().GetBody()
+		sequence, err := interop.Sequence(body)
+		require.NoError(t, err)
+		if sequence != received || len(body) != floodSizes[received%len(floodSizes)] {
+			mismatched++
+		}
+		received++
+	}
+	assert.Equal(t, floodMessages, received)
+	assert.Zero(t, mismatched, "messages out of order or of the wrong size")
+	t.Successf("successful server streaming zero interval flood")
+}
+
+// DoUnaryCallWithCustomUserAgent performs unary RPCs asking the server to echo
+// the user-agent it observed. grpc-go appends its own user-agent to the one set
+// with grpc.WithUserAgent, so the client with the custom user-agent must have
+// it reach the server followed by the grpc-go version.
+func DoUnaryCallWithCustomUserAgent(t crosstesting.TB, client, userAgentClient testpb.TestServiceClient, userAgent string, args ...grpc.CallOption) {
+	echoUserAgent := func(client testpb.TestServiceClient) string {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), echoUserAgentKey, "true")
+		var header metadata.MD
+		_, err := client.UnaryCall(
+			ctx,
+			&testpb.SimpleRequest{
+				ResponseType: testpb.PayloadType_COMPRESSABLE,
+				ResponseSize: int32(1),
+			},
+			append(args, grpc.Header(&header))...,
+		)
+		require.NoError(t, err)
+		return strings.Join(header.Get(echoUserAgentKey), ",")
+	}
+	assert.Equal(t, "grpc-go/"+grpc.Version, echoUserAgent(client))
+	assert.Equal(t, userAgent+" grpc-go/"+grpc.Version, echoUserAgent(userAgentClient))
+	t.Successf("successful unary call with custom user-agent")
+}
+
+// DoOversizedMetadata sends unary calls with increasingly large leading
+// metadata values, and checks that each value is either echoed back without
+// truncation or rejected with the status RESOURCE_EXHAUSTED.
+func DoOversizedMetadata(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for _, size := range oversizedMetadataSizes {
+		value := strings.Repeat("a", size)
+		ctx := metadata.AppendToOutgoingContext(context.Background(), leadingMetadataKey, value)
+		var header metadata.MD
+		_, err := client.UnaryCall(
+			ctx,
+			&testpb.SimpleRequest{
+				ResponseType: testpb.PayloadType_COMPRESSABLE,
+				ResponseSize: int32(1),
+			},
+			append(args, grpc.Header(&header))...,
+		)
+		if err != nil {
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%d bytes of metadata: %v", size, err)
+			continue
+		}
+		assert.Equal(t, []string{value}, header.Get(leadingMetadataKey), "%d bytes of metadata", size)
+	}
+	t.Successf("successful oversized metadata")
+}
+
+// DoOrcaPerRPC performs a unary RPC asking the server to record an ORCA load
+// report, and checks that the report is attached to the response trailers.
+func DoOrcaPerRPC(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	orcaReport := &testpb.TestOrcaReport{
+		CpuUtilization:    0.8210,
+		MemoryUtilization: 0.5847,
+		RequestCost:       map[string]float64{"cost": 3456.32},
+		Utilization:       map[string]float64{"util": 0.30499},
+	}
+	var trailer metadata.MD
+	_, err := client.UnaryCall(
+		context.Background(),
+		&testpb.SimpleRequest{
+			OrcaPerQueryReport: orcaReport,
+		},
+		append(args, grpc.Trailer(&trailer))...,
+	)
+	require.NoError(t, err)
+	values := trailer.Get(interop.OrcaLoadReportKey)
+	require.Len(t, values, 1, "expected one %s trailer", interop.OrcaLoadReportKey)
+	loadReport := &orcav3.OrcaLoadReport{}
+	require.NoError(t, proto.Unmarshal([]byte(values[0]), loadReport))
+	assert.Equal(t, orcaReport.CpuUtilization, loadReport.CpuUtilization)
+	assert.Equal(t, orcaReport.MemoryUtilization, loadReport.MemUtilization)
+	assert.Equal(t, orcaReport.RequestCost, loadReport.RequestCost)
+	assert.Equal(t, orcaReport.Utilization, loadReport.Utilization)
+	t.Successf("successful orca per rpc")
+}
+
+// DoServerReflection lists the services of the server with the gRPC server
+// reflection protocol (v1alpha), expecting the test service to be listed, then
+// asks for the file declaring the test service.
+func DoServerReflection(t crosstesting.TB, client reflectionpb.ServerReflectionClient, args ...grpc.CallOption) {
+	stream, err := client.ServerReflectionInfo(context.Background(), args...)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Contains(t, services, "grpc.testing.TestService")
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "grpc.testing.TestService"},
+	}))
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	fileDescriptors := resp.GetFileDescriptorResponse().GetFileDescriptorProto()
+	require.NotEmpty(t, fileDescriptors, "unexpected response: %v", resp)
+	// the file containing the symbol comes before its dependencies
+	fileDescriptor := &descriptorpb.FileDescriptorProto{}
+	require.NoError(t, proto.Unmarshal(fileDescriptors[0], fileDescriptor))
+	assert.Equal(t, "grpc/testing/test.proto", fileDescriptor.GetName())
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	t.Successf("successful server reflection")
+}
+
+// DoHealthCheck checks the health of the server and of the test service with
+// the gRPC health checking protocol, expecting both to be serving, and expects
+// checking an unknown service to fail with the status NOT_FOUND.
+func DoHealthCheck(t crosstesting.TB, client healthv1.HealthClient, args ...grpc.CallOption) {
+	for _, service := range []string{"", "grpc.testing.TestService"} {
+		resp, err := client.Check(context.Background(), &healthv1.HealthCheckRequest{Service: service}, args...)
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.GetStatus(), "service %q", service)
+	}
+	_, err := client.Check(context.Background(), &healthv1.HealthCheckRequest{Service: "grpc.testing.UnknownService"}, args...)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	t.Successf("successful health check")
+}
+
 func customMetadataTest(t crosstesting.TB, client testpb.TestServiceClient, customMetadata metadata.MD, args ...grpc.CallOption) {
 	// Testing with UnaryCall.
 	customMetadataUnaryTest(t, client, customMetadata, args...)
@@ -450,6 +1470,181 @@ func DoStatusCodeAndMessage(t crosstesting.TB, client testpb.TestServiceClient,
 	t.Successf("successful status code and message")
 }
 
+// DoAllStatusCodes performs a unary RPC for each error code, from Canceled
+// to Unauthenticated, and checks that the code and message requested from the
+// server are propagated back to the client.
+func DoAllStatusCodes(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for code := codes.Canceled; code <= codes.Unauthenticated; code++ {
+		msg := "test status message for " + code.String()
+		req := &testpb.SimpleRequest{
+			ResponseStatus: &testpb.EchoStatus{
+				Code:    int32(code),
+				Message: msg,
+			},
+		}
+		_, err := client.UnaryCall(context.Background(), req, args...)
+		require.Error(t, err, "code %v", code)
+		assert.Equal(t, code, status.Code(err))
+		assert.Equal(t, msg, status.Convert(err).Message(), "code %v", code)
+	}
+	t.Successf("successful all status codes")
+}
+
+// DoUnaryWithInvalidArgument performs unary RPCs requesting a response with
+// a negative size, and with an unsupported payload type, and expects the
+// server to reject both as invalid arguments.
+func DoUnaryWithInvalidArgument(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for _, req := range []*testpb.SimpleRequest{
+		{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseSize: -1},
+		{ResponseType: unsupportedPayloadType, ResponseSize: 1},
+	} {
+		_, err := client.UnaryCall(context.Background(), req, args...)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "response type %v and size %d", req.ResponseType, req.ResponseSize)
+	}
+	t.Successf("successful unary with invalid argument")
+}
+
+// DoServerStreamingWithInvalidArgument performs server streaming RPCs whose
+// second response has a negative size, and whose responses have an
+// unsupported payload type, and expects the server to fail both streams as
+// invalid arguments, after sending the first response of the former.
+func DoServerStreamingWithInvalidArgument(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for _, testCase := range []struct {
+		req       *testpb.StreamingOutputCallRequest
+		responses int
+	}{
+		{
+			req: &testpb.StreamingOutputCallRequest{
+				ResponseType:       testpb.PayloadType_COMPRESSABLE,
+				ResponseParameters: []*testpb.ResponseParameters{{Size: 1}, {Size: -1}},
+			},
+			responses: 1,
+		},
+		{
+			req: &testpb.StreamingOutputCallRequest{
+				ResponseType:       unsupportedPayloadType,
+				ResponseParameters: []*testpb.ResponseParameters{{Size: 1}},
+			},
+		},
+	} {
+		stream, err := client.StreamingOutputCall(context.Background(), testCase.req, args...)
+		require.NoError(t, err)
+		var respCnt int
+		for {
+			_, err = stream.Recv()
+			if err != nil {
+				break
+			}
+			respCnt++
+		}
+		assert.Equal(t, testCase.responses, respCnt)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	t.Successf("successful server streaming with invalid argument")
+}
+
+// DoFullDuplexWithInvalidArgument performs a full duplex RPC whose second
+// request asks for a response with a negative size, and one whose first request
+// asks for an unsupported payload type, and expects the server to fail both
+// streams as invalid arguments, after responding to the first request of the
+// former.
+func DoFullDuplexWithInvalidArgument(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	for _, testCase := range []struct {
+		reqs      []*testpb.StreamingOutputCallRequest
+		responses int
+	}{
+		{
+			reqs: []*testpb.StreamingOutputCallRequest{
+				{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseParameters: []*testpb.ResponseParameters{{Size: 1}}},
+				{ResponseType: testpb.PayloadType_COMPRESSABLE, ResponseParameters: []*testpb.ResponseParameters{{Size: -1}}},
+			},
+			responses: 1,
+		},
+		{
+			reqs: []*testpb.StreamingOutputCallRequest{
+				{ResponseType: unsupportedPayloadType, ResponseParameters: []*testpb.ResponseParameters{{Size: 1}}},
+			},
+		},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.FullDuplexCall(ctx, args...)
+		require.NoError(t, err)
+		var respCnt int
+		for _, req := range testCase.reqs {
+			require.NoError(t, stream.Send(req))
+			if _, err = stream.Recv(); err != nil {
+				break
+			}
+			respCnt++
+		}
+		cancel()
+		assert.Equal(t, testCase.responses, respCnt)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error: %v", err)
+	}
+	t.Successf("successful full duplex with invalid argument")
+}
+
+// DoUnaryWithServerSideContextDeadlinePropagation performs a unary RPC with a
+// deadline, asking the server to sleep past it, and expects the status
+// DEADLINE_EXCEEDED. It then asks the server to sleep briefly without a
+// deadline, and expects the call to succeed.
+func DoUnaryWithServerSideContextDeadlinePropagation(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, interop.UnarySleepKey, (2 * time.Second).String())
+	start := time.Now()
+	_, err := client.UnaryCall(ctx, &testpb.SimpleRequest{}, args...)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err), "unexpected error: %v", err)
+	assert.Less(t, time.Since(start), 2*time.Second, "call didn't fail before the server finished sleeping")
+	// without a deadline, the server sleeps for the whole duration
+	ctx = metadata.AppendToOutgoingContext(context.Background(), interop.UnarySleepKey, (50 * time.Millisecond).String())
+	_, err = client.UnaryCall(ctx, &testpb.SimpleRequest{}, args...)
+	require.NoError(t, err)
+	t.Successf("successful unary with server-side context deadline propagation")
+}
+
+// DoResponseStatusWithTrailingMetadata checks that the trailing metadata echoed
+// by the server is delivered in the trailers along with the requested status
+// of a unary call.
+func DoResponseStatusWithTrailingMetadata(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	msg := "test status message"
+	req := &testpb.SimpleRequest{
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    int32(codes.Unknown),
+			Message: msg,
+		},
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), trailingMetadataKey, trailingMetadataValue)
+	var trailer metadata.MD
+	_, err := client.UnaryCall(ctx, req, append(args, grpc.Trailer(&trailer))...)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+	assert.Equal(t, msg, status.Convert(err).Message())
+	assert.Equal(t, []string{trailingMetadataValue}, trailer.Get(trailingMetadataKey))
+	t.Successf("successful response status with trailing metadata")
+}
+
+// DoServerStreamingEarlyError performs a server streaming RPC asking for an
+// error status without any response, and expects the stream to fail with the
+// exact status before receiving any message.
+func DoServerStreamingEarlyError(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	const msg = "server streaming early error"
+	stream, err := client.StreamingOutputCall(context.Background(), &testpb.StreamingOutputCallRequest{
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    int32(codes.FailedPrecondition),
+			Message: msg,
+		},
+	}, args...)
+	require.NoError(t, err)
+	reply, err := stream.Recv()
+	assert.Nil(t, reply, "received a message before the error")
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, msg, status.Convert(err).Message())
+	t.Successf("successful server streaming early error")
+}
+
 // DoSpecialStatusMessage verifies Unicode and whitespace is correctly processed
 // in status message.
 func DoSpecialStatusMessage(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
@@ -470,6 +1665,47 @@ func DoSpecialStatusMessage(t crosstesting.TB, client testpb.TestServiceClient,
 	t.Successf("successful special status message")
 }
 
+// DoStatusCodeAndMessageServerStreaming checks that the status code and a
+// message with whitespace and Unicode are propagated back to the client after
+// the preceding messages of a server streaming call.
+func DoStatusCodeAndMessageServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	code := int32(codes.Unknown)
+	msg := "\t\ntest with whitespace\r\nand Unicode BMP ☺ and non-BMP 😈\t\n"
+	expectedErr := status.Error(codes.Code(code), msg)
+	respParam := make([]*testpb.ResponseParameters, len(respSizes))
+	for i, s := range respSizes {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(s),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+		ResponseStatus: &testpb.EchoStatus{
+			Code:    code,
+			Message: msg,
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	var rpcStatus error
+	var respCnt int
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			rpcStatus = err
+			break
+		}
+		assert.Equal(t, len(reply.GetPayload().GetBody()), respSizes[respCnt])
+		respCnt++
+	}
+	assert.Equal(t, rpcStatus.Error(), expectedErr.Error())
+	assert.Equal(t, respCnt, len(respSizes))
+	t.Successf("successful status code and message server streaming")
+}
+
 // DoUnimplementedMethod attempts to call an unimplemented method.
 func DoUnimplementedMethod(t crosstesting.TB, cc *grpc.ClientConn, args ...grpc.CallOption) {
 	var req, reply proto.Message
@@ -528,6 +1764,32 @@ func DoFailWithNonASCIIError(t crosstesting.TB, client testpb.TestServiceClient,
 	t.Successf("successful fail call with non-ASCII error")
 }
 
+// DoErrorWithDetails performs a unary RPC that always returns an error with
+// RetryInfo and QuotaFailure error details.
+func DoErrorWithDetails(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	reply, err := client.FailUnaryCallWithDetails(
+		context.Background(),
+		&testpb.SimpleRequest{
+			ResponseType: testpb.PayloadType_COMPRESSABLE,
+		},
+		args...,
+	)
+	assert.Nil(t, reply)
+	assert.Error(t, err)
+	errStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, errStatus.Code(), codes.ResourceExhausted)
+	assert.Equal(t, errStatus.Message(), interop.QuotaErrMsg)
+	require.Len(t, errStatus.Details(), 2)
+	retryInfo, ok := errStatus.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.True(t, proto.Equal(retryInfo, interop.RetryInfo))
+	quotaFailure, ok := errStatus.Details()[1].(*errdetails.QuotaFailure)
+	require.True(t, ok)
+	assert.True(t, proto.Equal(quotaFailure, interop.QuotaFailure))
+	t.Successf("successful error with details")
+}
+
 // DoFailServerStreamingWithNonASCIIError performs a server streaming RPC that always return a readable non-ASCII error.
 func DoFailServerStreamingWithNonASCIIError(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	respParam := make([]*testpb.ResponseParameters, len(respSizes))
@@ -558,6 +1820,47 @@ func DoFailServerStreamingWithNonASCIIError(t crosstesting.TB, client testpb.Tes
 	t.Successf("successful fail server streaming with non-ASCII error")
 }
 
+// DoFailServerStreaming performs a server streaming RPC that fails with a
+// non-ASCII error after sending two responses, which must be received before
+// the error.
+func DoFailServerStreaming(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
+	sizes := []int{31415, 9}
+	respParam := make([]*testpb.ResponseParameters, len(sizes))
+	for i, s := range sizes {
+		respParam[i] = &testpb.ResponseParameters{
+			Size: int32(s),
+		}
+	}
+	req := &testpb.StreamingOutputCallRequest{
+		ResponseType:       testpb.PayloadType_COMPRESSABLE,
+		ResponseParameters: respParam,
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), interop.FailAfterResponsesKey, "true")
+	stream, err := client.FailStreamingOutputCall(ctx, req, args...)
+	require.NoError(t, err)
+	var respCnt int
+	for {
+		var reply *testpb.StreamingOutputCallResponse
+		reply, err = stream.Recv()
+		if err != nil {
+			break
+		}
+		require.Less(t, respCnt, len(sizes), "received more than %d responses", len(sizes))
+		assert.Equal(t, sizes[respCnt], len(reply.GetPayload().GetBody()))
+		respCnt++
+	}
+	assert.Equal(t, len(sizes), respCnt)
+	errStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, errStatus.Code())
+	assert.Equal(t, interop.NonASCIIErrMsg, errStatus.Message())
+	require.Len(t, errStatus.Details(), 1)
+	errorDetail, ok := errStatus.Details()[0].(*testpb.ErrorDetail)
+	require.True(t, ok)
+	assert.True(t, proto.Equal(errorDetail, interop.ErrorDetail))
+	t.Successf("successful fail server streaming")
+}
+
 // DoUnresolvableHost attempts to call a method to an unresolvable host.
 func DoUnresolvableHost(t crosstesting.TB, client testpb.TestServiceClient, args ...grpc.CallOption) {
 	reply, err := client.EmptyCall(context.Background(), &testpb.Empty{}, args...)
@@ -38,8 +38,9 @@ package interopgrpc
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
+	"log"
+	"strconv"
 	"time"
 
 	testpb "github.com/bufbuild/connect-crosstest/internal/gen/proto/go/grpc/testing"
@@ -47,31 +48,62 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-// NewTestServer creates a test server for test service.
-func NewTestServer() testpb.TestServiceServer {
-	return &testServer{}
+// NewTestServer creates a test server for test service. Random payloads are
+// generated from the given seed.
+func NewTestServer(seed int64) testpb.TestServiceServer {
+	return &testServer{
+		rand: interop.NewRand(seed),
+	}
 }
 
 type testServer struct {
 	testpb.UnimplementedTestServiceServer
+
+	rand *interop.Rand
 }
 
 func (s *testServer) EmptyCall(ctx context.Context, in *testpb.Empty) (*testpb.Empty, error) {
+	if data, ok := metadata.FromIncomingContext(ctx); ok {
+		if leadingMetadata, ok := data[leadingMetadataKey]; ok {
+			header := metadata.Pairs(createMetadataPairs(leadingMetadataKey, leadingMetadata)...)
+			if err := grpc.SetHeader(ctx, header); err != nil {
+				return nil, err
+			}
+		}
+		if trailingMetadata, ok := data[trailingMetadataKey]; ok {
+			trailer := metadata.Pairs(createMetadataPairs(trailingMetadataKey, trailingMetadata)...)
+			if err := grpc.SetTrailer(ctx, trailer); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return new(testpb.Empty), nil
 }
 
-func serverNewPayload(payloadType testpb.PayloadType, size int32) (*testpb.Payload, error) {
+// serverNewPayload returns a payload of the given type and size. Its errors
+// are invalid argument statuses, which the handlers return as they are.
+func (s *testServer) serverNewPayload(payloadType testpb.PayloadType, size int32) (*testpb.Payload, error) {
 	if size < 0 {
-		return nil, fmt.Errorf("requested a response with invalid length %d", size)
+		return nil, status.Errorf(codes.InvalidArgument, "requested a response with invalid length %d", size)
+	}
+	if payloadType == testpb.PayloadType_RANDOM {
+		payloadType = []testpb.PayloadType{
+			testpb.PayloadType_COMPRESSABLE,
+			testpb.PayloadType_UNCOMPRESSABLE,
+		}[s.rand.Intn(2)]
 	}
 	body := make([]byte, size)
 	switch payloadType {
 	case testpb.PayloadType_COMPRESSABLE:
+	case testpb.PayloadType_UNCOMPRESSABLE:
+		s.rand.Read(body)
 	default:
-		return nil, fmt.Errorf("unsupported payload type: %d", payloadType)
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported payload type: %d", payloadType)
 	}
 	return &testpb.Payload{
 		Type: payloadType,
@@ -88,10 +120,61 @@ func createMetadataPairs(metadataKey string, metadata []string) []string {
 	return metadataPairs
 }
 
+// isCompressed reports whether the request messages of the stream associated
+// with ctx were compressed. The transport stream exposes the negotiated
+// compression, which is not available through the incoming metadata.
+func isCompressed(ctx context.Context) bool {
+	stream, ok := grpc.ServerTransportStreamFromContext(ctx).(interface{ RecvCompress() string })
+	if !ok {
+		return false
+	}
+	compression := stream.RecvCompress()
+	return compression != "" && compression != "identity"
+}
+
 func (s *testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	// measure the time remaining until the deadline first, so that it's as
+	// close as possible to the timeout sent by the client
+	deadline, hasDeadline := ctx.Deadline()
+	remaining := time.Until(deadline)
+	if req.GetExpectCompressed().GetValue() && !isCompressed(ctx) {
+		return nil, status.Error(codes.InvalidArgument, "expected compressed request, but got uncompressed request")
+	}
+	// ResponseCompressed isn't honored: grpc-go compresses the response with the
+	// compressor of the request, and has no option to opt a single response out
+	// of it
 	responseStatus := req.GetResponseStatus()
 	var header, trailer metadata.MD
 	if data, ok := metadata.FromIncomingContext(ctx); ok {
+		if failAttempts := data.Get(interop.FailAttemptsKey); len(failAttempts) > 0 {
+			var previousAttempts string
+			if previous := data.Get(interop.PreviousAttemptsKey); len(previous) > 0 {
+				previousAttempts = previous[0]
+			}
+			attempt, fail, err := interop.ParseAttempt(failAttempts[0], previousAttempts)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			if fail {
+				return nil, status.Error(codes.Unavailable, interop.FailedAttemptErrMsg(attempt))
+			}
+		}
+		if sleep := data.Get(interop.UnarySleepKey); len(sleep) > 0 {
+			duration, err := time.ParseDuration(sleep[0])
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			// stop sleeping as soon as the deadline is exceeded, logging it so that
+			// the deadline can be observed on the server, and check the context
+			// again in case the deadline expired as the sleep ended
+			if err := interop.Sleep(ctx, duration); err != nil {
+				log.Printf("UnaryCall stopped sleeping: %v", err)
+				return nil, status.FromContextError(err).Err()
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, status.FromContextError(err).Err()
+			}
+		}
 		if leadingMetadata, ok := data[leadingMetadataKey]; ok {
 			metadataPairs := createMetadataPairs(leadingMetadataKey, leadingMetadata)
 			header = metadata.Pairs(metadataPairs...)
@@ -100,9 +183,43 @@ func (s *testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (
 			trailingMetadataPairs := createMetadataPairs(trailingMetadataKey, trailingMetadata)
 			trailer = metadata.Pairs(trailingMetadataPairs...)
 		}
+		if _, ok := data[echoDeadlineKey]; ok && hasDeadline {
+			header = metadata.Join(header, metadata.Pairs(echoDeadlineKey, remaining.String()))
+		}
+		if _, ok := data[echoUserAgentKey]; ok {
+			header = metadata.Join(header, metadata.MD{echoUserAgentKey: data.Get("user-agent")})
+		}
+		if _, ok := data[echoRequestSizeKey]; ok {
+			header = metadata.Join(header, metadata.Pairs(echoRequestSizeKey, strconv.Itoa(len(req.GetPayload().GetBody()))))
+		}
+		if _, ok := data[echoPeerKey]; ok {
+			clientPeer, ok := peer.FromContext(ctx)
+			if !ok {
+				return nil, status.Error(codes.Internal, "unknown peer")
+			}
+			header = metadata.Join(header, metadata.Pairs(echoPeerKey, clientPeer.Addr.String()))
+		}
+		if count := data.Get(interop.TrailerCountKey); len(count) > 0 {
+			var size string
+			if sizes := data.Get(interop.TrailerSizeKey); len(sizes) > 0 {
+				size = sizes[0]
+			}
+			trailers, err := interop.ParseTrailers(count[0], size)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			trailer = metadata.Join(trailer, metadata.New(trailers))
+		}
+	}
+	if orcaReport := req.GetOrcaPerQueryReport(); orcaReport != nil {
+		loadReport, err := proto.Marshal(interop.NewOrcaLoadReport(orcaReport))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		trailer = metadata.Join(trailer, metadata.Pairs(interop.OrcaLoadReportKey, string(loadReport)))
 	}
 	if header != nil {
-		if err := grpc.SendHeader(ctx, header); err != nil {
+		if err := grpc.SetHeader(ctx, header); err != nil {
 			return nil, err
 		}
 	}
@@ -114,7 +231,7 @@ func (s *testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (
 	if responseStatus != nil && responseStatus.Code != 0 {
 		return nil, status.Error(codes.Code(responseStatus.Code), responseStatus.Message)
 	}
-	pl, err := serverNewPayload(req.GetResponseType(), req.GetResponseSize())
+	pl, err := s.serverNewPayload(req.GetResponseType(), req.GetResponseSize())
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +240,15 @@ func (s *testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (
 	}, nil
 }
 
+func (s *testServer) CacheableUnaryCall(ctx context.Context, in *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs("cache-control", interop.CacheControl)); err != nil {
+		return nil, err
+	}
+	return &testpb.SimpleResponse{
+		Payload: interop.NewCacheablePayload(),
+	}, nil
+}
+
 // FailUnaryCall is an additional RPC added for cross tests.
 func (s *testServer) FailUnaryCall(ctx context.Context, in *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
 	errStatus := status.New(codes.ResourceExhausted, interop.NonASCIIErrMsg)
@@ -133,8 +259,19 @@ func (s *testServer) FailUnaryCall(ctx context.Context, in *testpb.SimpleRequest
 	return nil, errStatus.Err()
 }
 
+func (s *testServer) FailUnaryCallWithDetails(ctx context.Context, in *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	errStatus := status.New(codes.ResourceExhausted, interop.QuotaErrMsg)
+	errStatus, err := errStatus.WithDetails(interop.RetryInfo, interop.QuotaFailure)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error when adding error details")
+	}
+	return nil, errStatus.Err()
+}
+
 func (s *testServer) StreamingOutputCall(args *testpb.StreamingOutputCallRequest, stream testpb.TestService_StreamingOutputCallServer) error {
+	var sequence bool
 	if data, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		_, sequence = data[interop.SequencePayloadKey]
 		if leadingMetadata, ok := data[leadingMetadataKey]; ok {
 			var metadataPairs []string
 			for _, metadataValue := range leadingMetadata {
@@ -156,25 +293,64 @@ func (s *testServer) StreamingOutputCall(args *testpb.StreamingOutputCallRequest
 			stream.SetTrailer(trailer)
 		}
 	}
+	var statusErr error
+	if st := args.GetResponseStatus(); st != nil && st.Code != 0 {
+		statusErr = status.Error(codes.Code(st.Code), st.Message)
+	}
 	cs := args.GetResponseParameters()
-	for _, c := range cs {
-		if us := c.GetIntervalUs(); us > 0 {
-			time.Sleep(time.Duration(us) * time.Microsecond)
+	// fail right away when there's no response to send, since failing before the
+	// first message frames differently than failing mid-stream
+	if statusErr != nil && len(cs) == 0 {
+		return statusErr
+	}
+	// the Compressed parameters aren't honored: grpc-go compresses all the
+	// messages in a stream with the compressor of the request, and has no option
+	// to opt a single message out of it
+	for i, c := range cs {
+		// stop waiting as soon as the client cancels or the deadline is exceeded,
+		// logging it so that the cancellation can be observed on the server
+		if err := interop.Sleep(stream.Context(), time.Duration(c.GetIntervalUs())*time.Microsecond); err != nil {
+			log.Printf("StreamingOutputCall stopped after %d responses: %v", i, err)
+			return status.FromContextError(err).Err()
 		}
-		pl, err := serverNewPayload(args.GetResponseType(), c.GetSize())
+		pl, err := s.serverNewPayload(args.GetResponseType(), c.GetSize())
 		if err != nil {
 			return err
 		}
+		if sequence {
+			if err := interop.PutSequence(pl.Body, i); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		// generating a large payload takes a while, so check the context again
+		// before sending it
+		if err := stream.Context().Err(); err != nil {
+			log.Printf("StreamingOutputCall stopped after %d responses: %v", i, err)
+			return status.FromContextError(err).Err()
+		}
 		if err := stream.Send(&testpb.StreamingOutputCallResponse{
 			Payload: pl,
 		}); err != nil {
 			return err
 		}
 	}
-	return nil
+	return statusErr
 }
 
 func (s *testServer) FailStreamingOutputCall(args *testpb.StreamingOutputCallRequest, stream testpb.TestService_FailStreamingOutputCallServer) error {
+	if data, ok := metadata.FromIncomingContext(stream.Context()); ok && len(data[interop.FailAfterResponsesKey]) > 0 {
+		for _, c := range args.GetResponseParameters() {
+			pl, err := s.serverNewPayload(args.GetResponseType(), c.GetSize())
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&testpb.StreamingOutputCallResponse{
+				Payload: pl,
+			}); err != nil {
+				return err
+			}
+		}
+	}
 	errStatus := status.New(codes.ResourceExhausted, interop.NonASCIIErrMsg)
 	errStatus, err := errStatus.WithDetails(interop.ErrorDetail)
 	if err != nil {
@@ -195,7 +371,13 @@ func (s *testServer) StreamingInputCall(stream testpb.TestService_StreamingInput
 		if err != nil {
 			return err
 		}
+		if req.GetExpectCompressed().GetValue() && !isCompressed(stream.Context()) {
+			return status.Error(codes.InvalidArgument, "expected compressed request, but got uncompressed request")
+		}
 		p := req.GetPayload().GetBody()
+		if string(p) == interop.AbortClientStreamBody {
+			return status.Error(codes.Aborted, interop.AbortClientStreamErrMsg)
+		}
 		sum += len(p)
 	}
 }
@@ -208,6 +390,9 @@ func (s *testServer) FullDuplexCall(stream testpb.TestService_FullDuplexCallServ
 				metadataPairs = append(metadataPairs, leadingMetadataKey)
 				metadataPairs = append(metadataPairs, metadataValue)
 			}
+			if len(data.Get(interop.FirstResponseDelayKey)) > 0 {
+				metadataPairs = append(metadataPairs, interop.HeadersFlushedKey, "true")
+			}
 			header := metadata.Pairs(metadataPairs...)
 			if err := stream.SendHeader(header); err != nil {
 				return err
@@ -222,6 +407,16 @@ func (s *testServer) FullDuplexCall(stream testpb.TestService_FullDuplexCallServ
 			trailer := metadata.Pairs(trailingMetadataPairs...)
 			stream.SetTrailer(trailer)
 		}
+		if delay := data.Get(interop.FirstResponseDelayKey); len(delay) > 0 {
+			duration, err := time.ParseDuration(delay[0])
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			// the headers sent above are flushed before the first response
+			if err := interop.Sleep(stream.Context(), duration); err != nil {
+				return status.FromContextError(err).Err()
+			}
+		}
 	}
 	for {
 		req, err := stream.Recv()
@@ -241,7 +436,7 @@ func (s *testServer) FullDuplexCall(stream testpb.TestService_FullDuplexCallServ
 			if us := c.GetIntervalUs(); us > 0 {
 				time.Sleep(time.Duration(us) * time.Microsecond)
 			}
-			pl, err := serverNewPayload(req.GetResponseType(), c.GetSize())
+			pl, err := s.serverNewPayload(req.GetResponseType(), c.GetSize())
 			if err != nil {
 				return err
 			}
@@ -273,7 +468,7 @@ func (s *testServer) HalfDuplexCall(stream testpb.TestService_HalfDuplexCallServ
 			if us := c.GetIntervalUs(); us > 0 {
 				time.Sleep(time.Duration(us) * time.Microsecond)
 			}
-			pl, err := serverNewPayload(msg.GetResponseType(), c.GetSize())
+			pl, err := s.serverNewPayload(msg.GetResponseType(), c.GetSize())
 			if err != nil {
 				return err
 			}
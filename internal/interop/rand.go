@@ -0,0 +1,48 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Rand is a seeded source of pseudo-random data that is safe for concurrent
+// use, so that payloads generated by the test servers are reproducible.
+type Rand struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewRand returns a Rand seeded with the given seed.
+func NewRand(seed int64) *Rand {
+	return &Rand{
+		rand: rand.New(rand.NewSource(seed)), // nolint:gosec // payloads don't need a cryptographically secure source
+	}
+}
+
+// Read fills bytes with pseudo-random data.
+func (r *Rand) Read(bytes []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = r.rand.Read(bytes)
+}
+
+// Intn returns a pseudo-random number in [0,n).
+func (r *Rand) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rand.Intn(n)
+}
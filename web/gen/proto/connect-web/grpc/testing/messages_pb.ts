@@ -50,10 +50,26 @@ export enum PayloadType {
    * @generated from enum value: COMPRESSABLE = 0;
    */
   COMPRESSABLE = 0,
+
+  /**
+   * Uncompressable binary format.
+   *
+   * @generated from enum value: UNCOMPRESSABLE = 1;
+   */
+  UNCOMPRESSABLE = 1,
+
+  /**
+   * Randomly chosen from all other formats defined in this enum.
+   *
+   * @generated from enum value: RANDOM = 2;
+   */
+  RANDOM = 2,
 }
 // Retrieve enum metadata with: proto3.getEnumType(PayloadType)
 proto3.util.setEnumType(PayloadType, "grpc.testing.PayloadType", [
   { no: 0, name: "COMPRESSABLE" },
+  { no: 1, name: "UNCOMPRESSABLE" },
+  { no: 2, name: "RANDOM" },
 ]);
 
 /**
@@ -313,6 +329,13 @@ export class SimpleRequest extends Message<SimpleRequest> {
    */
   fillGrpclbRouteType = false;
 
+  /**
+   * If set the server should record this metrics report data for the current RPC.
+   *
+   * @generated from field: grpc.testing.TestOrcaReport orca_per_query_report = 11;
+   */
+  orcaPerQueryReport?: TestOrcaReport;
+
   constructor(data?: PartialMessage<SimpleRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -331,6 +354,7 @@ export class SimpleRequest extends Message<SimpleRequest> {
     { no: 8, name: "expect_compressed", kind: "message", T: BoolValue },
     { no: 9, name: "fill_server_id", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 10, name: "fill_grpclb_route_type", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "orca_per_query_report", kind: "message", T: TestOrcaReport },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimpleRequest {
@@ -1331,3 +1355,62 @@ export class ErrorStatus extends Message<ErrorStatus> {
   }
 }
 
+/**
+ * Metrics data the server will update and send to the client. It mirrors orca load report
+ * https://github.com/cncf/xds/blob/eded343319d09f30032952beda9840bbd3dcf7ac/xds/data/orca/v3/orca_load_report.proto#L15,
+ * but avoids orca dependency. Used by both per-query and out-of-band reporting tests.
+ *
+ * @generated from message grpc.testing.TestOrcaReport
+ */
+export class TestOrcaReport extends Message<TestOrcaReport> {
+  /**
+   * @generated from field: double cpu_utilization = 1;
+   */
+  cpuUtilization = 0;
+
+  /**
+   * @generated from field: double memory_utilization = 2;
+   */
+  memoryUtilization = 0;
+
+  /**
+   * @generated from field: map<string, double> request_cost = 3;
+   */
+  requestCost: { [key: string]: number } = {};
+
+  /**
+   * @generated from field: map<string, double> utilization = 4;
+   */
+  utilization: { [key: string]: number } = {};
+
+  constructor(data?: PartialMessage<TestOrcaReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "grpc.testing.TestOrcaReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cpu_utilization", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "memory_utilization", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "request_cost", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 1 /* ScalarType.DOUBLE */} },
+    { no: 4, name: "utilization", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 1 /* ScalarType.DOUBLE */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestOrcaReport {
+    return new TestOrcaReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestOrcaReport {
+    return new TestOrcaReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestOrcaReport {
+    return new TestOrcaReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestOrcaReport | PlainMessage<TestOrcaReport> | undefined, b: TestOrcaReport | PlainMessage<TestOrcaReport> | undefined): boolean {
+    return proto3.util.equals(TestOrcaReport, a, b);
+  }
+}
+
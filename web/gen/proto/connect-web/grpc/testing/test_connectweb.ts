@@ -17,6 +17,8 @@
 // The TestService has been extended to include the following RPCs:
 // FailUnaryCall(SimpleRequest) returns (SimpleResponse): this RPC is a unary
 // call that always returns a readable non-ASCII error with error details.
+// FailUnaryCallWithDetails(SimpleRequest) returns (SimpleResponse): this RPC is a unary
+// call that always returns an error with RetryInfo and QuotaFailure error details.
 // FailStreamingOutputCall(StreamingOutputCallRequest) returns (stream StreamingOutputCallResponse):
 // this RPC is a server streaming call that always returns a readable non-ASCII error with error details.
 // UnimplementedStreamingOutputCall(grpc.testing.Empty) returns (stream grpc.testing.Empty): this RPC
@@ -94,6 +96,18 @@ export const TestService = {
       O: SimpleResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * One request followed by one response. This RPC always fails with error
+     * details of well-known types.
+     *
+     * @generated from rpc grpc.testing.TestService.FailUnaryCallWithDetails
+     */
+    failUnaryCallWithDetails: {
+      name: "FailUnaryCallWithDetails",
+      I: SimpleRequest,
+      O: SimpleResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * One request followed by one response. Response has cache control
      * headers set such that a caching HTTP proxy (such as GFE) can
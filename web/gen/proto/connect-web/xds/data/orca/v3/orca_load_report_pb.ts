@@ -0,0 +1,115 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This is copied from the xDS API's ORCA Protobuf definitions, without the validation rules:
+// https://github.com/cncf/xds/blob/main/xds/data/orca/v3/orca_load_report.proto
+
+// Copyright 2019 Envoy Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// @generated by protoc-gen-es v0.0.9 with parameter "target=ts"
+// @generated from file xds/data/orca/v3/orca_load_report.proto (package xds.data.orca.v3, syntax proto3)
+/* eslint-disable */
+/* @ts-nocheck */
+
+import type {BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage} from "@bufbuild/protobuf";
+import {Message, proto3, protoInt64} from "@bufbuild/protobuf";
+
+/**
+ * @generated from message xds.data.orca.v3.OrcaLoadReport
+ */
+export class OrcaLoadReport extends Message<OrcaLoadReport> {
+  /**
+   * CPU utilization expressed as a fraction of available CPU resources. This
+   * should be derived from the latest sample or measurement.
+   *
+   * @generated from field: double cpu_utilization = 1;
+   */
+  cpuUtilization = 0;
+
+  /**
+   * Memory utilization expressed as a fraction of available memory
+   * resources. This should be derived from the latest sample or measurement.
+   *
+   * @generated from field: double mem_utilization = 2;
+   */
+  memUtilization = 0;
+
+  /**
+   * Total RPS being served by an endpoint. This should cover all services that an endpoint is
+   * responsible for.
+   *
+   * @generated from field: uint64 rps = 3;
+   */
+  rps = protoInt64.zero;
+
+  /**
+   * Application specific requests costs. Each value is an absolute cost (e.g. 3487 bytes of
+   * storage) associated with the request.
+   *
+   * @generated from field: map<string, double> request_cost = 4;
+   */
+  requestCost: { [key: string]: number } = {};
+
+  /**
+   * Resource utilization values. Each value is expressed as a fraction of total resources
+   * available, derived from the latest sample or measurement.
+   *
+   * @generated from field: map<string, double> utilization = 5;
+   */
+  utilization: { [key: string]: number } = {};
+
+  constructor(data?: PartialMessage<OrcaLoadReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "xds.data.orca.v3.OrcaLoadReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cpu_utilization", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "mem_utilization", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "rps", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "request_cost", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 1 /* ScalarType.DOUBLE */} },
+    { no: 5, name: "utilization", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 1 /* ScalarType.DOUBLE */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrcaLoadReport {
+    return new OrcaLoadReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrcaLoadReport {
+    return new OrcaLoadReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrcaLoadReport {
+    return new OrcaLoadReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrcaLoadReport | PlainMessage<OrcaLoadReport> | undefined, b: OrcaLoadReport | PlainMessage<OrcaLoadReport> | undefined): boolean {
+    return proto3.util.equals(OrcaLoadReport, a, b);
+  }
+}
+
@@ -0,0 +1,61 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as jspb from 'google-protobuf'
+
+
+
+export class HealthCheckRequest extends jspb.Message {
+  getService(): string;
+  setService(value: string): HealthCheckRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HealthCheckRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: HealthCheckRequest): HealthCheckRequest.AsObject;
+  static serializeBinaryToWriter(message: HealthCheckRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HealthCheckRequest;
+  static deserializeBinaryFromReader(message: HealthCheckRequest, reader: jspb.BinaryReader): HealthCheckRequest;
+}
+
+export namespace HealthCheckRequest {
+  export type AsObject = {
+    service: string,
+  }
+}
+
+export class HealthCheckResponse extends jspb.Message {
+  getStatus(): HealthCheckResponse.ServingStatus;
+  setStatus(value: HealthCheckResponse.ServingStatus): HealthCheckResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HealthCheckResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: HealthCheckResponse): HealthCheckResponse.AsObject;
+  static serializeBinaryToWriter(message: HealthCheckResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HealthCheckResponse;
+  static deserializeBinaryFromReader(message: HealthCheckResponse, reader: jspb.BinaryReader): HealthCheckResponse;
+}
+
+export namespace HealthCheckResponse {
+  export type AsObject = {
+    status: HealthCheckResponse.ServingStatus,
+  }
+
+  export enum ServingStatus { 
+    UNKNOWN = 0,
+    SERVING = 1,
+    NOT_SERVING = 2,
+    SERVICE_UNKNOWN = 3,
+  }
+}
+
@@ -179,6 +179,49 @@ export class TestServiceClient {
     this.methodDescriptorFailUnaryCall);
   }
 
+  methodDescriptorFailUnaryCallWithDetails = new grpcWeb.MethodDescriptor(
+    '/grpc.testing.TestService/FailUnaryCallWithDetails',
+    grpcWeb.MethodType.UNARY,
+    grpc_testing_messages_pb.SimpleRequest,
+    grpc_testing_messages_pb.SimpleResponse,
+    (request: grpc_testing_messages_pb.SimpleRequest) => {
+      return request.serializeBinary();
+    },
+    grpc_testing_messages_pb.SimpleResponse.deserializeBinary
+  );
+
+  failUnaryCallWithDetails(
+    request: grpc_testing_messages_pb.SimpleRequest,
+    metadata: grpcWeb.Metadata | null): Promise<grpc_testing_messages_pb.SimpleResponse>;
+
+  failUnaryCallWithDetails(
+    request: grpc_testing_messages_pb.SimpleRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.RpcError,
+               response: grpc_testing_messages_pb.SimpleResponse) => void): grpcWeb.ClientReadableStream<grpc_testing_messages_pb.SimpleResponse>;
+
+  failUnaryCallWithDetails(
+    request: grpc_testing_messages_pb.SimpleRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.RpcError,
+               response: grpc_testing_messages_pb.SimpleResponse) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        this.hostname_ +
+          '/grpc.testing.TestService/FailUnaryCallWithDetails',
+        request,
+        metadata || {},
+        this.methodDescriptorFailUnaryCallWithDetails,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/grpc.testing.TestService/FailUnaryCallWithDetails',
+    request,
+    metadata || {},
+    this.methodDescriptorFailUnaryCallWithDetails);
+  }
+
   methodDescriptorCacheableUnaryCall = new grpcWeb.MethodDescriptor(
     '/grpc.testing.TestService/CacheableUnaryCall',
     grpcWeb.MethodType.UNARY,
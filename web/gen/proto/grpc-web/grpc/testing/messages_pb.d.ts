@@ -120,6 +120,11 @@ export class SimpleRequest extends jspb.Message {
   getFillGrpclbRouteType(): boolean;
   setFillGrpclbRouteType(value: boolean): SimpleRequest;
 
+  getOrcaPerQueryReport(): TestOrcaReport | undefined;
+  setOrcaPerQueryReport(value?: TestOrcaReport): SimpleRequest;
+  hasOrcaPerQueryReport(): boolean;
+  clearOrcaPerQueryReport(): SimpleRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SimpleRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SimpleRequest): SimpleRequest.AsObject;
@@ -140,6 +145,7 @@ export namespace SimpleRequest {
     expectCompressed?: BoolValue.AsObject,
     fillServerId: boolean,
     fillGrpclbRouteType: boolean,
+    orcaPerQueryReport?: TestOrcaReport.AsObject,
   }
 }
 
@@ -613,8 +619,40 @@ export namespace ErrorStatus {
   }
 }
 
+export class TestOrcaReport extends jspb.Message {
+  getCpuUtilization(): number;
+  setCpuUtilization(value: number): TestOrcaReport;
+
+  getMemoryUtilization(): number;
+  setMemoryUtilization(value: number): TestOrcaReport;
+
+  getRequestCostMap(): jspb.Map<string, number>;
+  clearRequestCostMap(): TestOrcaReport;
+
+  getUtilizationMap(): jspb.Map<string, number>;
+  clearUtilizationMap(): TestOrcaReport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestOrcaReport.AsObject;
+  static toObject(includeInstance: boolean, msg: TestOrcaReport): TestOrcaReport.AsObject;
+  static serializeBinaryToWriter(message: TestOrcaReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestOrcaReport;
+  static deserializeBinaryFromReader(message: TestOrcaReport, reader: jspb.BinaryReader): TestOrcaReport;
+}
+
+export namespace TestOrcaReport {
+  export type AsObject = {
+    cpuUtilization: number,
+    memoryUtilization: number,
+    requestCostMap: Array<[string, number]>,
+    utilizationMap: Array<[string, number]>,
+  }
+}
+
 export enum PayloadType { 
   COMPRESSABLE = 0,
+  UNCOMPRESSABLE = 1,
+  RANDOM = 2,
 }
 export enum GrpclbRouteType { 
   GRPCLB_ROUTE_TYPE_UNKNOWN = 0,
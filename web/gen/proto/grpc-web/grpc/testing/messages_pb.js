@@ -57,6 +57,7 @@ goog.exportSymbol('proto.grpc.testing.StreamingInputCallRequest', null, global);
 goog.exportSymbol('proto.grpc.testing.StreamingInputCallResponse', null, global);
 goog.exportSymbol('proto.grpc.testing.StreamingOutputCallRequest', null, global);
 goog.exportSymbol('proto.grpc.testing.StreamingOutputCallResponse', null, global);
+goog.exportSymbol('proto.grpc.testing.TestOrcaReport', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -540,6 +541,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.testing.ErrorStatus.displayName = 'proto.grpc.testing.ErrorStatus';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.testing.TestOrcaReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.testing.TestOrcaReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.testing.TestOrcaReport.displayName = 'proto.grpc.testing.TestOrcaReport';
+}
 
 
 
@@ -1055,7 +1077,8 @@ proto.grpc.testing.SimpleRequest.toObject = function(includeInstance, msg) {
     responseStatus: (f = msg.getResponseStatus()) && proto.grpc.testing.EchoStatus.toObject(includeInstance, f),
     expectCompressed: (f = msg.getExpectCompressed()) && proto.grpc.testing.BoolValue.toObject(includeInstance, f),
     fillServerId: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
-    fillGrpclbRouteType: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+    fillGrpclbRouteType: jspb.Message.getBooleanFieldWithDefault(msg, 10, false),
+    orcaPerQueryReport: (f = msg.getOrcaPerQueryReport()) && proto.grpc.testing.TestOrcaReport.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1136,6 +1159,11 @@ proto.grpc.testing.SimpleRequest.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setFillGrpclbRouteType(value);
       break;
+    case 11:
+      var value = new proto.grpc.testing.TestOrcaReport;
+      reader.readMessage(value,proto.grpc.testing.TestOrcaReport.deserializeBinaryFromReader);
+      msg.setOrcaPerQueryReport(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1239,6 +1267,14 @@ proto.grpc.testing.SimpleRequest.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getOrcaPerQueryReport();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.grpc.testing.TestOrcaReport.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -1498,6 +1534,43 @@ proto.grpc.testing.SimpleRequest.prototype.setFillGrpclbRouteType = function(val
 };
 
 
+/**
+ * optional TestOrcaReport orca_per_query_report = 11;
+ * @return {?proto.grpc.testing.TestOrcaReport}
+ */
+proto.grpc.testing.SimpleRequest.prototype.getOrcaPerQueryReport = function() {
+  return /** @type{?proto.grpc.testing.TestOrcaReport} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.testing.TestOrcaReport, 11));
+};
+
+
+/**
+ * @param {?proto.grpc.testing.TestOrcaReport|undefined} value
+ * @return {!proto.grpc.testing.SimpleRequest} returns this
+*/
+proto.grpc.testing.SimpleRequest.prototype.setOrcaPerQueryReport = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.testing.SimpleRequest} returns this
+ */
+proto.grpc.testing.SimpleRequest.prototype.clearOrcaPerQueryReport = function() {
+  return this.setOrcaPerQueryReport(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.testing.SimpleRequest.prototype.hasOrcaPerQueryReport = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
 
 
 
@@ -5008,11 +5081,239 @@ proto.grpc.testing.ErrorStatus.prototype.clearDetailsList = function() {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.testing.TestOrcaReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.testing.TestOrcaReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.testing.TestOrcaReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuUtilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    memoryUtilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    requestCostMap: (f = msg.getRequestCostMap()) ? f.toObject(includeInstance, undefined) : [],
+    utilizationMap: (f = msg.getUtilizationMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.testing.TestOrcaReport}
+ */
+proto.grpc.testing.TestOrcaReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.testing.TestOrcaReport;
+  return proto.grpc.testing.TestOrcaReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.testing.TestOrcaReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.testing.TestOrcaReport}
+ */
+proto.grpc.testing.TestOrcaReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCpuUtilization(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMemoryUtilization(value);
+      break;
+    case 3:
+      var value = msg.getRequestCostMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readDouble, null, "", 0.0);
+         });
+      break;
+    case 4:
+      var value = msg.getUtilizationMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readDouble, null, "", 0.0);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.testing.TestOrcaReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.testing.TestOrcaReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.testing.TestOrcaReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getMemoryUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getRequestCostMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeDouble);
+  }
+  f = message.getUtilizationMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeDouble);
+  }
+};
+
+
+/**
+ * optional double cpu_utilization = 1;
+ * @return {number}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.getCpuUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.testing.TestOrcaReport} returns this
+ */
+proto.grpc.testing.TestOrcaReport.prototype.setCpuUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double memory_utilization = 2;
+ * @return {number}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.getMemoryUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.testing.TestOrcaReport} returns this
+ */
+proto.grpc.testing.TestOrcaReport.prototype.setMemoryUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * map<string, double> request_cost = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.getRequestCostMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.testing.TestOrcaReport} returns this
+ */
+proto.grpc.testing.TestOrcaReport.prototype.clearRequestCostMap = function() {
+  this.getRequestCostMap().clear();
+  return this;};
+
+
+/**
+ * map<string, double> utilization = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.grpc.testing.TestOrcaReport.prototype.getUtilizationMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.testing.TestOrcaReport} returns this
+ */
+proto.grpc.testing.TestOrcaReport.prototype.clearUtilizationMap = function() {
+  this.getUtilizationMap().clear();
+  return this;};
+
+
 /**
  * @enum {number}
  */
 proto.grpc.testing.PayloadType = {
-  COMPRESSABLE: 0
+  COMPRESSABLE: 0,
+  UNCOMPRESSABLE: 1,
+  RANDOM: 2
 };
 
 /**
@@ -0,0 +1,52 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as jspb from 'google-protobuf'
+
+
+
+export class OrcaLoadReport extends jspb.Message {
+  getCpuUtilization(): number;
+  setCpuUtilization(value: number): OrcaLoadReport;
+
+  getMemUtilization(): number;
+  setMemUtilization(value: number): OrcaLoadReport;
+
+  getRps(): number;
+  setRps(value: number): OrcaLoadReport;
+
+  getRequestCostMap(): jspb.Map<string, number>;
+  clearRequestCostMap(): OrcaLoadReport;
+
+  getUtilizationMap(): jspb.Map<string, number>;
+  clearUtilizationMap(): OrcaLoadReport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): OrcaLoadReport.AsObject;
+  static toObject(includeInstance: boolean, msg: OrcaLoadReport): OrcaLoadReport.AsObject;
+  static serializeBinaryToWriter(message: OrcaLoadReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): OrcaLoadReport;
+  static deserializeBinaryFromReader(message: OrcaLoadReport, reader: jspb.BinaryReader): OrcaLoadReport;
+}
+
+export namespace OrcaLoadReport {
+  export type AsObject = {
+    cpuUtilization: number,
+    memUtilization: number,
+    rps: number,
+    requestCostMap: Array<[string, number]>,
+    utilizationMap: Array<[string, number]>,
+  }
+}
+
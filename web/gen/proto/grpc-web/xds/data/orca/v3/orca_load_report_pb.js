@@ -0,0 +1,310 @@
+// Copyright 2022 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// source: xds/data/orca/v3/orca_load_report.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global = (function() { return this || window || global || self || Function('return this')(); }).call(null);
+
+goog.exportSymbol('proto.xds.data.orca.v3.OrcaLoadReport', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.xds.data.orca.v3.OrcaLoadReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.xds.data.orca.v3.OrcaLoadReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.xds.data.orca.v3.OrcaLoadReport.displayName = 'proto.xds.data.orca.v3.OrcaLoadReport';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.xds.data.orca.v3.OrcaLoadReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.xds.data.orca.v3.OrcaLoadReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuUtilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    memUtilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    rps: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    requestCostMap: (f = msg.getRequestCostMap()) ? f.toObject(includeInstance, undefined) : [],
+    utilizationMap: (f = msg.getUtilizationMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.xds.data.orca.v3.OrcaLoadReport;
+  return proto.xds.data.orca.v3.OrcaLoadReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.xds.data.orca.v3.OrcaLoadReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCpuUtilization(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMemUtilization(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRps(value);
+      break;
+    case 4:
+      var value = msg.getRequestCostMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readDouble, null, "", 0.0);
+         });
+      break;
+    case 5:
+      var value = msg.getUtilizationMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readDouble, null, "", 0.0);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.xds.data.orca.v3.OrcaLoadReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.xds.data.orca.v3.OrcaLoadReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getMemUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getRps();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getRequestCostMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeDouble);
+  }
+  f = message.getUtilizationMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeDouble);
+  }
+};
+
+
+/**
+ * optional double cpu_utilization = 1;
+ * @return {number}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.getCpuUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport} returns this
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.setCpuUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double mem_utilization = 2;
+ * @return {number}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.getMemUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport} returns this
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.setMemUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 rps = 3;
+ * @return {number}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.getRps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport} returns this
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.setRps = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * map<string, double> request_cost = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.getRequestCostMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport} returns this
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.clearRequestCostMap = function() {
+  this.getRequestCostMap().clear();
+  return this;};
+
+
+/**
+ * map<string, double> utilization = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.getUtilizationMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.xds.data.orca.v3.OrcaLoadReport} returns this
+ */
+proto.xds.data.orca.v3.OrcaLoadReport.prototype.clearUtilizationMap = function() {
+  this.getUtilizationMap().clear();
+  return this;};
+
+
+goog.object.extend(exports, proto.xds.data.orca.v3);